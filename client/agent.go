@@ -1,23 +1,19 @@
 package client
 
+import "iter"
+
 type Agent struct {
 	AgentKey string `json:"agentKey"`
 }
 
 // Deprecated: use AgentPools() instead.
 func (client *ApiClient) Agents() ([]Agent, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []Agent
-
-	if err := client.http.Get("/agents", map[string]string{"organizationId": organizationId}, &result); err != nil {
-		return nil, err
-	}
+	return collect(client.AgentsIter())
+}
 
-	return result, nil
+// AgentsIter returns an iterator over all the agents. The endpoint isn't paginated, so they are fetched in a single request.
+func (client *ApiClient) AgentsIter() iter.Seq2[Agent, error] {
+	return paginate(organizationPages[Agent](client, organizationEndpoint("/agents"), singlePage[Agent]))
 }
 
 // Deprecated: use AgentPool() and AgentSecrets() instead.
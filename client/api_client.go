@@ -3,17 +3,26 @@ package client
 //go:generate mockgen -destination=api_client_mock.go -package=client . ApiClientInterface
 
 import (
+	"context"
+	"iter"
+	"time"
+
 	"github.com/env0/terraform-provider-env0/client/http"
 )
 
 type ApiClient struct {
 	http                  http.HttpClientInterface
-	cachedOrganizationId  string
+	cachedOrganizationId  *cachedOrganizationId
 	defaultOrganizationId string
-	memoizedGetTeams      func(string) ([]Team, error)
+	cache                 *cache
+	ctx                   context.Context
+	// organizationIdOverride is the organization of this copy of the client (see WithOrganizationId).
+	organizationIdOverride string
 }
 
 type ApiClientInterface interface {
+	WithContext(ctx context.Context) ApiClientInterface
+	WithOrganizationId(organizationId string) ApiClientInterface
 	ConfigurationVariablesByScope(scope Scope, scopeId string) ([]ConfigurationVariable, error)
 	ConfigurationVariablesById(id string) (ConfigurationVariable, error)
 	ConfigurationVariableCreate(params ConfigurationVariableCreateParams) (ConfigurationVariable, error)
@@ -27,6 +36,7 @@ type ApiClientInterface interface {
 	Policy(projectId string) (Policy, error)
 	PolicyUpdate(payload PolicyUpdatePayload) (Policy, error)
 	Projects() ([]Project, error)
+	ProjectsIter() iter.Seq2[Project, error]
 	Project(id string) (Project, error)
 	ProjectCreate(payload ProjectCreatePayload) (Project, error)
 	ProjectUpdate(id string, payload ProjectUpdatePayload) (Project, error)
@@ -35,6 +45,7 @@ type ApiClientInterface interface {
 	ModuleTestingProject() (*ModuleTestingProject, error)
 	Template(id string) (Template, error)
 	Templates() ([]Template, error)
+	TemplatesIter() iter.Seq2[Template, error]
 	TemplatesByName(name string) ([]Template, error)
 	TemplateCreate(payload TemplateCreatePayload) (Template, error)
 	TemplateUpdate(id string, payload TemplateCreatePayload) (Template, error)
@@ -43,6 +54,7 @@ type ApiClientInterface interface {
 	RemoveTemplateFromProject(templateId string, projectId string) error
 	VariablesFromRepository(payload *VariablesFromRepositoryPayload) ([]ConfigurationVariable, error)
 	SshKeys() ([]SshKey, error)
+	SshKeysIter() iter.Seq2[SshKey, error]
 	SshKeyCreate(payload SshKeyCreatePayload) (*SshKey, error)
 	SshKeyUpdate(id string, payload *SshKeyUpdatePayload) (*SshKey, error)
 	SshKeyDelete(id string) error
@@ -50,6 +62,7 @@ type ApiClientInterface interface {
 	CredentialsUpdate(id string, request any) (Credentials, error)
 	CloudCredentials(id string) (Credentials, error)
 	CloudCredentialsList() ([]Credentials, error)
+	CloudCredentialsIter() iter.Seq2[Credentials, error]
 	CloudCredentialsDelete(id string) error
 	AssignCloudCredentialsToProject(projectId string, credentialId string) (CloudCredentialsProjectAssignment, error)
 	RemoveCloudCredentialsFromProject(projectId string, credentialId string) error
@@ -59,6 +72,7 @@ type ApiClientInterface interface {
 	RemoveCostCredentialsFromProject(projectId string, credentialId string) error
 	Team(id string) (Team, error)
 	Teams() ([]Team, error)
+	TeamsIter() iter.Seq2[Team, error]
 	TeamsByName(name string) ([]Team, error)
 	TeamCreate(payload TeamCreatePayload) (Team, error)
 	TeamUpdate(id string, payload TeamUpdatePayload) (Team, error)
@@ -85,6 +99,7 @@ type ApiClientInterface interface {
 	EnvironmentUpdateDriftDetection(environmentId string, payload EnvironmentSchedulingExpression) (EnvironmentSchedulingExpression, error)
 	EnvironmentStopDriftDetection(environmentId string) error
 	Notifications() ([]Notification, error)
+	NotificationsIter() iter.Seq2[Notification, error]
 	NotificationCreate(payload NotificationCreatePayload) (*Notification, error)
 	NotificationDelete(id string) error
 	NotificationUpdate(id string, payload NotificationUpdatePayload) (*Notification, error)
@@ -95,16 +110,20 @@ type ApiClientInterface interface {
 	ModuleDelete(id string) error
 	ModuleUpdate(id string, payload ModuleUpdatePayload) (*Module, error)
 	Modules() ([]Module, error)
+	ModulesIter() iter.Seq2[Module, error]
 	GitToken(id string) (*GitToken, error)
 	GitTokens() ([]GitToken, error)
+	GitTokensIter() iter.Seq2[GitToken, error]
 	GitTokenCreate(payload GitTokenCreatePayload) (*GitToken, error)
 	GitTokenDelete(id string) error
 	ApiKeyCreate(payload ApiKeyCreatePayload) (*ApiKey, error)
 	ApiKeyDelete(id string) error
 	ApiKeys() ([]ApiKey, error)
+	ApiKeysIter() iter.Seq2[ApiKey, error]
 	AssignAgentsToProjects(payload AssignProjectsAgentsAssignmentsPayload) (*ProjectsAgentsAssignments, error)
 	ProjectsAgentsAssignments() (*ProjectsAgentsAssignments, error)
 	Agents() ([]Agent, error)
+	AgentsIter() iter.Seq2[Agent, error]
 	AgentValues(id string) (string, error)
 	AgentPools() ([]AgentPool, error)
 	AgentPoolCreate(payload AgentPoolCreatePayload) (*AgentPool, error)
@@ -115,6 +134,7 @@ type ApiClientInterface interface {
 	AgentSecrets(agentId string) ([]AgentSecret, error)
 	AgentSecretDelete(agentId string, secretId string) error
 	Users() ([]OrganizationUser, error)
+	UsersIter() iter.Seq2[OrganizationUser, error]
 	AssignUserToProject(projectId string, payload *AssignUserToProjectPayload) (*UserProjectAssignment, error)
 	RemoveUserFromProject(projectId string, userId string) error
 	UserProjectAssignments(projectId string) ([]UserProjectAssignment, error)
@@ -124,6 +144,7 @@ type ApiClientInterface interface {
 	RoleDelete(id string) error
 	RoleUpdate(id string, payload RoleUpdatePayload) (*Role, error)
 	Roles() ([]Role, error)
+	RolesIter() iter.Seq2[Role, error]
 	CustomFlow(id string) (*CustomFlow, error)
 	CustomFlows(name string) ([]CustomFlow, error)
 	CustomFlowCreate(payload CustomFlowCreatePayload) (*CustomFlow, error)
@@ -188,13 +209,59 @@ type ApiClientInterface interface {
 }
 
 func NewApiClient(client http.HttpClientInterface, defaultOrganizationId string) ApiClientInterface {
-	apiClient := &ApiClient{
-		http:                  client,
-		cachedOrganizationId:  "",
+	return newApiClient(client, defaultOrganizationId, defaultCacheTTL)
+}
+
+func newApiClient(client http.HttpClientInterface, defaultOrganizationId string, cacheTTL time.Duration) *ApiClient {
+	cache := newCache(cacheTTL)
+
+	return &ApiClient{
+		http:                  &invalidatingHttpClient{HttpClientInterface: client, cache: cache},
+		cachedOrganizationId:  &cachedOrganizationId{},
 		defaultOrganizationId: defaultOrganizationId,
+		cache:                 cache,
+	}
+}
+
+// WithContext returns a copy of the client whose API calls are bound to ctx.
+// The copy shares the cache (and the cached organization id) with the original client.
+// Canceling ctx aborts rate limiter waits, retries and in-flight requests of the returned client.
+func (client *ApiClient) WithContext(ctx context.Context) ApiClientInterface {
+	clone := *client
+	clone.http = client.http.WithContext(ctx)
+	clone.ctx = ctx
+
+	return &clone
+}
+
+// WithOrganizationId returns a copy of the client that manages the resources of the given organization,
+// instead of the organization of the provider (for API keys that are assigned to multiple organizations).
+// The organization id is validated (once) against the organizations of the API key, on first use.
+// An empty organizationId returns the client as is.
+func (client *ApiClient) WithOrganizationId(organizationId string) ApiClientInterface {
+	if organizationId == "" {
+		return client
 	}
 
-	apiClient.memoizedGetTeams = memoize(apiClient.GetTeams)
+	clone := *client
+	clone.organizationIdOverride = organizationId
+
+	return &clone
+}
+
+// cacheKey returns the cache key of a list of resources of the client's organization.
+func (client *ApiClient) cacheKey(key string) string {
+	if client.organizationIdOverride == "" {
+		return key
+	}
+
+	return client.organizationIdOverride + ":" + key
+}
+
+func (client *ApiClient) context() context.Context {
+	if client.ctx == nil {
+		return context.Background()
+	}
 
-	return apiClient
+	return client.ctx
 }
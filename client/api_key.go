@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type ApiKey struct {
 	Id               string `json:"id"`
 	Name             string `json:"name"`
@@ -57,17 +59,12 @@ func (client *ApiClient) ApiKeyDelete(id string) error {
 }
 
 func (client *ApiClient) ApiKeys() ([]ApiKey, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []ApiKey
-	if err := client.http.Get("/api-keys", map[string]string{"organizationId": organizationId}, &result); err != nil {
-		return nil, err
-	}
+	return collect(client.ApiKeysIter())
+}
 
-	return result, err
+// ApiKeysIter returns an iterator over all the api keys. The endpoint isn't paginated, so they are fetched in a single request.
+func (client *ApiClient) ApiKeysIter() iter.Seq2[ApiKey, error] {
+	return paginate(organizationPages[ApiKey](client, organizationEndpoint("/api-keys"), singlePage[ApiKey]))
 }
 
 func (client *ApiClient) OidcSub() (string, error) {
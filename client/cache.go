@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+)
+
+// How long list lookups (e.g. Projects()) are cached.
+const defaultCacheTTL = time.Minute
+
+type cacheEntry struct {
+	value     any
+	expiresAt time.Time
+}
+
+// cache is a concurrency safe read cache shared by an ApiClient and all its copies (see WithContext).
+// Data sources and name based imports list the same resources over and over again; the cache saves these requests.
+// Errors are not cached. Any write request (POST, PUT, PATCH or DELETE) invalidates the whole cache.
+type cache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	// Incremented on every invalidation. Prevents caching results that were fetched before a write completed.
+	generation uint64
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *cache) get(key string) (any, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if ok && c.now().After(entry.expiresAt) {
+		delete(c.entries, key)
+
+		ok = false
+	}
+
+	return entry.value, c.generation, ok
+}
+
+func (c *cache) set(key string, value any, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	c.entries[key] = cacheEntry{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+func (c *cache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	clear(c.entries)
+}
+
+// cachedList returns the cached result of key, or calls f and caches its result.
+// A copy of the cached slice is returned, so callers may modify it.
+func cachedList[T any](c *cache, key string, f func() ([]T, error)) ([]T, error) {
+	cached, generation, ok := c.get(key)
+	if ok {
+		return slices.Clone(cached.([]T)), nil
+	}
+
+	value, err := f()
+	if err != nil {
+		return value, err
+	}
+
+	c.set(key, slices.Clone(value), generation)
+
+	return value, nil
+}
+
+// invalidatingHttpClient invalidates the cache after every write request.
+type invalidatingHttpClient struct {
+	http.HttpClientInterface
+	cache *cache
+}
+
+func (client *invalidatingHttpClient) WithContext(ctx context.Context) http.HttpClientInterface {
+	return &invalidatingHttpClient{HttpClientInterface: client.HttpClientInterface.WithContext(ctx), cache: client.cache}
+}
+
+func (client *invalidatingHttpClient) Post(path string, request any, response any) error {
+	defer client.cache.invalidate()
+
+	return client.HttpClientInterface.Post(path, request, response)
+}
+
+func (client *invalidatingHttpClient) Put(path string, request any, response any) error {
+	defer client.cache.invalidate()
+
+	return client.HttpClientInterface.Put(path, request, response)
+}
+
+func (client *invalidatingHttpClient) Patch(path string, request any, response any) error {
+	defer client.cache.invalidate()
+
+	return client.HttpClientInterface.Patch(path, request, response)
+}
+
+func (client *invalidatingHttpClient) Delete(path string, params map[string]string) error {
+	defer client.cache.invalidate()
+
+	return client.HttpClientInterface.Delete(path, params)
+}
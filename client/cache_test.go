@@ -0,0 +1,190 @@
+package client_test
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newCachedApiClient(t *testing.T) (client.ApiClientInterface, *http.MockHttpClientInterface, *time.Time) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	httpClient := http.NewMockHttpClientInterface(ctrl)
+
+	now := time.Now()
+
+	httpClient.EXPECT().Get("/organizations", nil, gomock.Any()).Times(1).Do(func(path string, params any, response *[]client.Organization) {
+		*response = []client.Organization{{Id: organizationId}}
+	})
+
+	return client.NewApiClientWithCache(httpClient, "", time.Minute, func() time.Time { return now }), httpClient, &now
+}
+
+func expectGetProjects(httpClient *http.MockHttpClientInterface, projects []client.Project) *gomock.Call {
+	return httpClient.EXPECT().Get("/projects", map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}, gomock.Any()).Do(func(path string, params any, response *[]client.Project) {
+		*response = slices.Clone(projects)
+	})
+}
+
+func TestCache(t *testing.T) {
+	projects := []client.Project{{Id: "id1", Name: "project1"}, {Id: "id2", Name: "project2"}}
+
+	t.Run("should cache results", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		expectGetProjects(httpClient, projects).Times(1)
+
+		result1, err := apiClient.Projects()
+		require.NoError(t, err)
+		assert.Equal(t, projects, result1)
+
+		// Modifying a result shouldn't modify the cache.
+		result1[0].Name = "modified"
+
+		result2, err := apiClient.Projects()
+		require.NoError(t, err)
+		assert.Equal(t, projects, result2)
+	})
+
+	t.Run("should not cache errors", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		expectedError := errors.New("error")
+
+		gomock.InOrder(
+			httpClient.EXPECT().Get("/projects", gomock.Any(), gomock.Any()).Times(1).Return(expectedError),
+			expectGetProjects(httpClient, projects).Times(1),
+		)
+
+		_, err := apiClient.Projects()
+		require.Equal(t, expectedError, err)
+
+		result, err := apiClient.Projects()
+		require.NoError(t, err)
+		assert.Equal(t, projects, result)
+
+		result, err = apiClient.Projects()
+		require.NoError(t, err)
+		assert.Equal(t, projects, result)
+	})
+
+	t.Run("should expire results after the ttl", func(t *testing.T) {
+		apiClient, httpClient, now := newCachedApiClient(t)
+
+		expectGetProjects(httpClient, projects).Times(2)
+
+		_, err := apiClient.Projects()
+		require.NoError(t, err)
+
+		*now = now.Add(59 * time.Second)
+
+		_, err = apiClient.Projects()
+		require.NoError(t, err)
+
+		*now = now.Add(2 * time.Second)
+
+		_, err = apiClient.Projects()
+		require.NoError(t, err)
+	})
+
+	t.Run("should invalidate on writes", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		expectGetProjects(httpClient, projects).Times(2)
+		httpClient.EXPECT().Delete("/projects/id1", nil).Times(1)
+
+		_, err := apiClient.Projects()
+		require.NoError(t, err)
+
+		require.NoError(t, apiClient.ProjectDelete("id1"))
+
+		_, err = apiClient.Projects()
+		require.NoError(t, err)
+	})
+
+	t.Run("should invalidate on failed writes", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		expectGetProjects(httpClient, projects).Times(2)
+		httpClient.EXPECT().Delete("/projects/id1", nil).Times(1).Return(errors.New("error"))
+
+		_, err := apiClient.Projects()
+		require.NoError(t, err)
+
+		require.Error(t, apiClient.ProjectDelete("id1"))
+
+		_, err = apiClient.Projects()
+		require.NoError(t, err)
+	})
+
+	t.Run("should share the cache with copies", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		httpClient.EXPECT().WithContext(gomock.Any()).Return(httpClient).AnyTimes()
+		expectGetProjects(httpClient, projects).Times(1)
+
+		_, err := apiClient.WithContext(t.Context()).Projects()
+		require.NoError(t, err)
+
+		_, err = apiClient.WithContext(t.Context()).Projects()
+		require.NoError(t, err)
+	})
+
+	t.Run("should cache teams by name", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		httpClient.EXPECT().Get("/teams/organizations/"+organizationId, map[string]string{"limit": "100"}, gomock.Any()).Times(1)
+		httpClient.EXPECT().Get("/teams/organizations/"+organizationId, map[string]string{"limit": "100", "name": "team"}, gomock.Any()).Times(1)
+
+		for range 2 {
+			_, err := apiClient.Teams()
+			require.NoError(t, err)
+
+			_, err = apiClient.TeamsByName("team")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		apiClient, httpClient, _ := newCachedApiClient(t)
+
+		httpClient.EXPECT().WithContext(gomock.Any()).Return(httpClient).AnyTimes()
+		expectGetProjects(httpClient, projects).MinTimes(1)
+		httpClient.EXPECT().Get("/roles", gomock.Any(), gomock.Any()).MinTimes(1)
+		httpClient.EXPECT().Delete(gomock.Any(), nil).AnyTimes()
+
+		var wg sync.WaitGroup
+
+		for i := range 50 {
+			wg.Go(func() {
+				apiClient := apiClient.WithContext(t.Context())
+
+				id, err := apiClient.OrganizationId()
+				assert.NoError(t, err)
+				assert.Equal(t, organizationId, id)
+
+				result, err := apiClient.Projects()
+				assert.NoError(t, err)
+				assert.Equal(t, projects, result)
+
+				_, err = apiClient.Roles()
+				assert.NoError(t, err)
+
+				if i%10 == 0 {
+					assert.NoError(t, apiClient.ProjectDelete("id1"))
+				}
+			})
+		}
+
+		wg.Wait()
+	})
+}
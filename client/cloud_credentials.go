@@ -1,6 +1,7 @@
 package client
 
 import (
+	"iter"
 	"strings"
 )
 
@@ -16,6 +17,7 @@ type Credentials struct {
 	OrganizationId string `json:"organizationId" tfschema:",omitempty"`
 	Type           string `json:"type"`
 	ProjectId      string `json:"projectId"      tfschema:",omitempty"`
+	CreatedAt      string `json:"createdAt"      tfschema:"-"`
 }
 
 func (c *Credentials) HasPrefix(prefixList []string) bool {
@@ -154,18 +156,12 @@ func (client *ApiClient) CloudCredentials(id string) (Credentials, error) {
 }
 
 func (client *ApiClient) CloudCredentialsList() ([]Credentials, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return []Credentials{}, err
-	}
-
-	var credentials []Credentials
-
-	if err := client.http.Get("/credentials", map[string]string{"organizationId": organizationId}, &credentials); err != nil {
-		return []Credentials{}, err
-	}
+	return cachedList(client.cache, client.cacheKey("credentials"), func() ([]Credentials, error) { return collect(client.CloudCredentialsIter()) })
+}
 
-	return credentials, nil
+// CloudCredentialsIter returns an iterator over all the credentials. Pages are fetched lazily, and results are not cached (unlike CloudCredentialsList()).
+func (client *ApiClient) CloudCredentialsIter() iter.Seq2[Credentials, error] {
+	return paginate(organizationPages[Credentials](client, organizationEndpoint("/credentials"), offsetPages[Credentials]))
 }
 
 func (client *ApiClient) CredentialsCreate(request any) (Credentials, error) {
@@ -191,12 +187,19 @@ func (client *ApiClient) CredentialsCreate(request any) (Credentials, error) {
 		return Credentials{}, err
 	}
 
-	var result Credentials
-	if err := client.http.Post("/credentials", request, &result); err != nil {
-		return Credentials{}, err
-	}
+	name, _ := requestMap["name"].(string)
+	projectIdStr, _ := projectId.(string)
 
-	return result, nil
+	return createOrAdopt(client, "credentials", name, func() (Credentials, error) {
+		var result Credentials
+		if err := client.http.Post("/credentials", request, &result); err != nil {
+			return Credentials{}, err
+		}
+
+		return result, nil
+	}, func() ([]Credentials, error) {
+		return client.lookupCredentials(name, projectIdStr)
+	}, func(object Credentials) string { return object.CreatedAt })
 }
 
 func (client *ApiClient) CredentialsUpdate(id string, request any) (Credentials, error) {
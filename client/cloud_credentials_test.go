@@ -406,7 +406,7 @@ var _ = Describe("CloudCredentials", func() {
 			mockOrganizationIdCall()
 
 			httpCall = mockHttpClient.EXPECT().
-				Get("/credentials", map[string]string{"organizationId": organizationId}, gomock.Any()).
+				Get("/credentials", map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}, gomock.Any()).
 				Do(func(path string, request any, response *[]Credentials) {
 					*response = keys
 				})
@@ -429,7 +429,7 @@ var _ = Describe("CloudCredentials", func() {
 			mockOrganizationIdCall()
 
 			httpCall = mockHttpClient.EXPECT().
-				Get("/credentials", map[string]string{"organizationId": organizationId}, gomock.Any()).
+				Get("/credentials", map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}, gomock.Any()).
 				Do(func(path string, request any, response *[]Credentials) {
 					*response = keys
 				})
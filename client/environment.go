@@ -87,15 +87,26 @@ type WorkflowFile struct {
 }
 
 type DeploymentLog struct {
-	Id                  string          `json:"id"`
-	BlueprintId         string          `json:"blueprintId"`
-	BlueprintRepository string          `json:"blueprintRepository"`
-	BlueprintRevision   string          `json:"blueprintRevision"`
-	Output              json.RawMessage `json:"output,omitempty"`
-	Error               json.RawMessage `json:"error,omitempty"`
-	Type                string          `json:"type"`
-	Status              string          `json:"status"`
-	WorkflowFile        *WorkflowFile   `json:"workflowFile,omitempty" tfschema:"-"`
+	Id                  string                 `json:"id"`
+	BlueprintId         string                 `json:"blueprintId"`
+	BlueprintRepository string                 `json:"blueprintRepository"`
+	BlueprintRevision   string                 `json:"blueprintRevision"`
+	Output              json.RawMessage        `json:"output,omitempty"`
+	Error               json.RawMessage        `json:"error,omitempty"`
+	Type                string                 `json:"type"`
+	Status              string                 `json:"status"`
+	WorkflowFile        *WorkflowFile          `json:"workflowFile,omitempty" tfschema:"-"`
+	CreatedAt           string                 `json:"createdAt,omitempty"`
+	StartedAt           string                 `json:"startedAt,omitempty"`
+	FinishedAt          string                 `json:"finishedAt,omitempty"`
+	PlanSummary         *DeploymentPlanSummary `json:"planSummary,omitempty"`
+}
+
+// DeploymentPlanSummary is the number of resources to add, change and destroy in the plan of a deployment.
+type DeploymentPlanSummary struct {
+	Added     int `json:"added"`
+	Changed   int `json:"changed"`
+	Destroyed int `json:"destroyed"`
 }
 
 type DriftDetectionRequest struct {
@@ -131,6 +142,7 @@ type Environment struct {
 	IsRemoteApplyEnabled        bool          `json:"isRemoteApplyEnabled"`
 	K8sNamespace                string        `json:"k8sNamespace"`
 	IsSingleUseBlueprint        bool          `json:"isSingleUseBlueprint"                  tfschema:"-"`
+	CreatedAt                   string        `json:"createdAt,omitempty"                   tfschema:"-"`
 }
 
 type EnvironmentCreate struct {
@@ -232,30 +244,26 @@ type EnvironmentDeployResponse struct {
 	Id string `json:"id"`
 }
 
-func (Environment) getEndpoint() string {
-	return "/environments"
-}
-
 func (client *ApiClient) EnvironmentsByName(name string) ([]Environment, error) {
 	organizationId, err := client.OrganizationId()
 	if err != nil {
 		return nil, err
 	}
 
-	return getAll(client, map[string]string{
+	return getAll[Environment](client, "/environments", map[string]string{
 		"organizationId": organizationId,
 		"name":           name,
 	})
 }
 
 func (client *ApiClient) ProjectEnvironments(projectId string) ([]Environment, error) {
-	return getAll(client, map[string]string{
+	return getAll[Environment](client, "/environments", map[string]string{
 		"projectId": projectId,
 	})
 }
 
 func (client *ApiClient) OrganizationEnvironments(organizationId string) ([]Environment, error) {
-	return getAll(client, map[string]string{
+	return getAll[Environment](client, "/environments", map[string]string{
 		"organizationId": organizationId,
 	})
 }
@@ -283,14 +291,17 @@ func (client *ApiClient) EnvironmentDeploymentLog(id string) (*DeploymentLog, er
 }
 
 func (client *ApiClient) EnvironmentCreate(payload EnvironmentCreate) (Environment, error) {
-	var result Environment
+	return createOrAdopt(client, "environment", payload.Name, func() (Environment, error) {
+		var result Environment
 
-	err := client.http.Post("/environments", payload, &result)
-	if err != nil {
-		return Environment{}, err
-	}
+		if err := client.http.Post("/environments", payload, &result); err != nil {
+			return Environment{}, err
+		}
 
-	return result, nil
+		return result, nil
+	}, func() ([]Environment, error) {
+		return client.lookupEnvironment(payload.Name, payload.ProjectId)
+	}, func(object Environment) string { return object.CreatedAt })
 }
 
 func (client *ApiClient) EnvironmentCreateWithoutTemplate(payload EnvironmentCreateWithoutTemplate) (Environment, error) {
@@ -303,11 +314,15 @@ func (client *ApiClient) EnvironmentCreateWithoutTemplate(payload EnvironmentCre
 
 	payload.TemplateCreate.OrganizationId = organizationId
 
-	if err := client.http.Post("/environments/without-template", payload, &result); err != nil {
-		return result, err
-	}
+	return createOrAdopt(client, "environment", payload.EnvironmentCreate.Name, func() (Environment, error) {
+		if err := client.http.Post("/environments/without-template", payload, &result); err != nil {
+			return result, err
+		}
 
-	return result, nil
+		return result, nil
+	}, func() ([]Environment, error) {
+		return client.lookupEnvironment(payload.EnvironmentCreate.Name, payload.EnvironmentCreate.ProjectId)
+	}, func(object Environment) string { return object.CreatedAt })
 }
 
 func (client *ApiClient) EnvironmentDestroy(id string) (*EnvironmentDestroyResponse, error) {
@@ -480,8 +480,11 @@ var _ = Describe("Environment Client", func() {
 		)
 
 		mockDeployment := DeploymentLog{
-			Id:     "id12345",
-			Status: "IN_PROGRESS",
+			Id:          "id12345",
+			Status:      "IN_PROGRESS",
+			CreatedAt:   "2024-01-01T00:00:00.000Z",
+			StartedAt:   "2024-01-01T00:01:00.000Z",
+			PlanSummary: &DeploymentPlanSummary{Added: 1, Changed: 2, Destroyed: 3},
 		}
 
 		BeforeEach(func() {
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+)
+
+// NewApiClientWithCache creates an api client with a custom cache ttl and clock, for the tests of package client_test.
+func NewApiClientWithCache(client http.HttpClientInterface, defaultOrganizationId string, ttl time.Duration, now func() time.Time) ApiClientInterface {
+	apiClient := newApiClient(client, defaultOrganizationId, ttl)
+	apiClient.cache.now = now
+
+	return apiClient
+}
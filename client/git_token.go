@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type GitToken struct {
 	Id             string `json:"id"`
 	Name           string `json:"name"`
@@ -52,15 +54,12 @@ func (client *ApiClient) GitTokenDelete(id string) error {
 }
 
 func (client *ApiClient) GitTokens() ([]GitToken, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []GitToken
-	if err := client.http.Get("/tokens", map[string]string{"organizationId": organizationId, "type": "GIT"}, &result); err != nil {
-		return nil, err
-	}
+	return collect(client.GitTokensIter())
+}
 
-	return result, err
+// GitTokensIter returns an iterator over all the git tokens. The endpoint isn't paginated, so they are fetched in a single request.
+func (client *ApiClient) GitTokensIter() iter.Seq2[GitToken, error] {
+	return paginate(organizationPages[GitToken](client, func(organizationId string) (string, map[string]string) {
+		return "/tokens", map[string]string{"organizationId": organizationId, "type": "GIT"}
+	}, singlePage[GitToken]))
 }
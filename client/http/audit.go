@@ -0,0 +1,165 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AuditRecord is a line of the audit log: a mutating (POST, PUT, PATCH or DELETE) request sent to the env0 API.
+type AuditRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Query     string    `json:"query,omitempty"`
+	// ResourceType and ResourceId are inferred from the path (E.g. "projects" and the project id of /projects/<id>/...).
+	// The id of a created object is taken from the response.
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceId   string `json:"resource_id,omitempty"`
+	// Payload is the request body, with secrets redacted (see RedactBody).
+	Payload   any    `json:"payload,omitempty"`
+	Status    int    `json:"status,omitempty"`
+	RequestId string `json:"request_id,omitempty"`
+	Attempt   int    `json:"attempt,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Workspace string `json:"workspace,omitempty"`
+}
+
+// AuditLog appends a JSON line (an AuditRecord) to a file for every mutating request (including retries) sent by a rest client.
+// It's safe for concurrent use.
+type AuditLog struct {
+	mu        sync.Mutex
+	file      *os.File
+	workspace string
+}
+
+// NewAuditLog opens (or creates) the audit log file. Records are appended to existing records.
+func NewAuditLog(path string, workspace string) (*AuditLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log %s: %w", path, err)
+	}
+
+	return &AuditLog{file: file, workspace: workspace}, nil
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case resty.MethodPost, resty.MethodPut, resty.MethodPatch, resty.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// Register records the mutating requests of the rest client.
+func (a *AuditLog) Register(restClient *resty.Client) {
+	restClient.
+		OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+			if isMutatingMethod(r.Request.Method) {
+				record := a.requestRecord(r.Request)
+				record.Status = r.StatusCode()
+				record.RequestId = responseRequestId(r)
+
+				if record.Method == resty.MethodPost && r.IsSuccess() {
+					var created struct {
+						Id string `json:"id"`
+					}
+
+					if err := json.Unmarshal(r.Body(), &created); err == nil && created.Id != "" {
+						record.ResourceId = created.Id
+					}
+				}
+
+				a.write(r.Request.Context(), record)
+			}
+
+			return nil
+		}).
+		OnError(func(r *resty.Request, err error) {
+			if isMutatingMethod(r.Method) {
+				record := a.requestRecord(r)
+				record.Error = err.Error()
+
+				a.write(r.Context(), record)
+			}
+		})
+}
+
+func (a *AuditLog) requestRecord(r *resty.Request) AuditRecord {
+	record := AuditRecord{
+		Timestamp: time.Now().UTC(),
+		Method:    r.Method,
+		Path:      r.URL,
+		Attempt:   r.Attempt,
+		Workspace: a.workspace,
+	}
+
+	if r.RawRequest != nil {
+		record.Path = r.RawRequest.URL.Path
+		record.Query = r.RawRequest.URL.RawQuery
+	}
+
+	segments := strings.Split(strings.Trim(record.Path, "/"), "/")
+	record.ResourceType = segments[0]
+
+	if len(segments) > 1 {
+		record.ResourceId = segments[1]
+	}
+
+	if payload := RedactBody(record.Path, r.Body); payload != "" {
+		if json.Valid([]byte(payload)) {
+			record.Payload = json.RawMessage(payload)
+		} else {
+			record.Payload = payload
+		}
+	}
+
+	return record
+}
+
+func (a *AuditLog) write(ctx context.Context, record AuditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		tflog.Error(ctx, "Failed to write the audit log", map[string]any{"error": err.Error()})
+
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file == nil {
+		tflog.Error(ctx, "Failed to write the audit log, it's already closed", map[string]any{"method": record.Method, "path": record.Path})
+
+		return
+	}
+
+	// A single write per record, so records of concurrent requests don't interleave.
+	if _, err := a.file.Write(append(line, '\n')); err != nil {
+		tflog.Error(ctx, "Failed to write the audit log", map[string]any{"file": a.file.Name(), "error": err.Error()})
+	}
+}
+
+// Close flushes the audit log file to disk and closes it. Closing an already closed audit log does nothing.
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.file == nil {
+		return nil
+	}
+
+	file := a.file
+	a.file = nil
+
+	return errors.Join(file.Sync(), file.Close())
+}
@@ -0,0 +1,183 @@
+package http_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit log", func() {
+	const BaseUrl = "https://fake.env0.com"
+
+	var (
+		httpclient *httpModule.HttpClient
+		auditLog   *httpModule.AuditLog
+		dir        string
+		path       string
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		dir, err = os.MkdirTemp("", "audit")
+		Expect(err).To(BeNil())
+
+		path = filepath.Join(dir, "audit.jsonl")
+
+		restClient := resty.New()
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		auditLog, err = httpModule.NewAuditLog(path, "production")
+		Expect(err).To(BeNil())
+		auditLog.Register(restClient)
+
+		httpclient, err = httpModule.NewHttpClient(httpModule.HttpClientConfig{
+			ApiKey:      "key",
+			ApiSecret:   "secret",
+			ApiEndpoint: BaseUrl,
+			RestClient:  restClient,
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+		Expect(auditLog.Close()).To(Succeed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	readRecords := func() []map[string]any {
+		file, err := os.Open(path)
+		Expect(err).To(BeNil())
+
+		defer file.Close()
+
+		var records []map[string]any
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var record map[string]any
+
+			Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed(), scanner.Text())
+
+			records = append(records, record)
+		}
+
+		Expect(scanner.Err()).To(BeNil())
+
+		return records
+	}
+
+	It("should record mutating requests", func() {
+		httpmock.RegisterResponder("POST", BaseUrl+"/projects", httpmock.NewJsonResponderOrPanic(200, map[string]string{"id": "project0", "name": "project"}))
+		httpmock.RegisterResponder("GET", BaseUrl+"/projects/project0", httpmock.NewJsonResponderOrPanic(200, map[string]string{"id": "project0"}))
+		httpmock.RegisterResponder("DELETE", BaseUrl+"/projects/project0", httpmock.NewStringResponder(200, ""))
+
+		var result map[string]string
+
+		Expect(httpclient.Post("/projects", map[string]string{"name": "project"}, &result)).To(Succeed())
+		Expect(httpclient.Get("/projects/project0", nil, &result)).To(Succeed())
+		Expect(httpclient.Delete("/projects/project0", map[string]string{"force": "true"})).To(Succeed())
+
+		records := readRecords()
+		Expect(records).To(HaveLen(2))
+
+		Expect(records[0]).To(HaveKey("timestamp"))
+		Expect(records[0]).To(HaveKeyWithValue("method", "POST"))
+		Expect(records[0]).To(HaveKeyWithValue("path", "/projects"))
+		Expect(records[0]).To(HaveKeyWithValue("resource_type", "projects"))
+		Expect(records[0]).To(HaveKeyWithValue("resource_id", "project0"))
+		Expect(records[0]).To(HaveKeyWithValue("payload", map[string]any{"name": "project"}))
+		Expect(records[0]).To(HaveKeyWithValue("status", float64(200)))
+		Expect(records[0]).To(HaveKeyWithValue("workspace", "production"))
+
+		Expect(records[1]).To(HaveKeyWithValue("method", "DELETE"))
+		Expect(records[1]).To(HaveKeyWithValue("path", "/projects/project0"))
+		Expect(records[1]).To(HaveKeyWithValue("query", "force=true"))
+		Expect(records[1]).To(HaveKeyWithValue("resource_id", "project0"))
+	})
+
+	It("should record failed requests", func() {
+		httpmock.RegisterResponder("PUT", BaseUrl+"/credentials/credentials0", func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(400, `{"message": "invalid"}`)
+			res.Header.Set("X-Request-Id", "request0")
+
+			return res, nil
+		})
+		httpmock.RegisterResponder("PATCH", BaseUrl+"/teams/team0", httpmock.NewErrorResponder(errors.New("connection reset")))
+
+		Expect(httpclient.Put("/credentials/credentials0", map[string]any{"name": "aws", "value": map[string]string{"secretAccessKey": "secret"}}, nil)).NotTo(Succeed())
+		Expect(httpclient.Patch("/teams/team0", map[string]string{"name": "team"}, nil)).NotTo(Succeed())
+
+		records := readRecords()
+		Expect(records).To(HaveLen(2))
+
+		Expect(records[0]).To(HaveKeyWithValue("status", float64(400)))
+		Expect(records[0]).To(HaveKeyWithValue("request_id", "request0"))
+		Expect(records[0]).To(HaveKeyWithValue("payload", map[string]any{"name": "aws", "value": map[string]any{"secretAccessKey": httpModule.Redacted}}))
+
+		Expect(records[1]).To(HaveKeyWithValue("method", "PATCH"))
+		Expect(records[1]).To(HaveKeyWithValue("error", ContainSubstring("connection reset")))
+		Expect(records[1]).NotTo(HaveKey("status"))
+	})
+
+	It("should record concurrent requests", func() {
+		const Requests = 50
+
+		httpmock.RegisterResponder("PUT", `=~^`+BaseUrl+`/projects/`, httpmock.NewStringResponder(200, "{}"))
+
+		var wg sync.WaitGroup
+
+		for range Requests {
+			wg.Go(func() {
+				Expect(httpclient.Put("/projects/project0", map[string]string{"name": "project"}, nil)).To(Succeed())
+			})
+		}
+
+		wg.Wait()
+
+		Expect(readRecords()).To(HaveLen(Requests))
+	})
+
+	It("should append to an existing audit log", func() {
+		httpmock.RegisterResponder("DELETE", BaseUrl+"/teams/team0", httpmock.NewStringResponder(200, ""))
+
+		Expect(httpclient.Delete("/teams/team0", nil)).To(Succeed())
+
+		anotherAuditLog, err := httpModule.NewAuditLog(path, "production")
+		Expect(err).To(BeNil())
+
+		anotherRestClient := resty.New()
+		httpmock.ActivateNonDefault(anotherRestClient.GetClient())
+		anotherAuditLog.Register(anotherRestClient)
+
+		_, err = anotherRestClient.R().Delete(BaseUrl + "/teams/team0")
+		Expect(err).To(BeNil())
+		Expect(anotherAuditLog.Close()).To(Succeed())
+
+		Expect(readRecords()).To(HaveLen(2))
+	})
+
+	It("should not record requests after it's closed", func() {
+		httpmock.RegisterResponder("DELETE", BaseUrl+"/teams/team0", httpmock.NewStringResponder(200, ""))
+
+		Expect(httpclient.Delete("/teams/team0", nil)).To(Succeed())
+		Expect(auditLog.Close()).To(Succeed())
+		// Closing again does nothing.
+		Expect(auditLog.Close()).To(Succeed())
+
+		Expect(httpclient.Delete("/teams/team0", nil)).To(Succeed())
+
+		Expect(readRecords()).To(HaveLen(1))
+	})
+})
@@ -4,13 +4,15 @@ package http
 
 import (
 	"context"
-	"reflect"
+	"net/http"
+	"time"
 
 	"github.com/env0/terraform-provider-env0/client/http/ratelimiter"
 	"github.com/go-resty/resty/v2"
 )
 
 type HttpClientInterface interface {
+	WithContext(ctx context.Context) HttpClientInterface
 	Get(path string, params map[string]string, response any) error
 	Post(path string, request any, response any) error
 	Put(path string, request any, response any) error
@@ -24,6 +26,9 @@ type HttpClient struct {
 	Endpoint    string
 	client      *resty.Client
 	rateLimiter *ratelimiter.RateLimiter
+	ctx         context.Context
+	getRequests *getCoalescer
+	usage       *usageStats
 }
 
 type HttpClientConfig struct {
@@ -41,22 +46,86 @@ func NewHttpClient(config HttpClientConfig) (*HttpClient, error) {
 		ApiSecret:   config.ApiSecret,
 		client:      config.RestClient.SetBaseURL(config.ApiEndpoint).SetHeader("User-Agent", config.UserAgent),
 		rateLimiter: &config.RateLimiter,
+		getRequests: newGetCoalescer(),
+		usage:       newUsageStats(),
+	}
+
+	traceAttempts(httpClient.client)
+	httpClient.usage.countAttempts(httpClient.client)
+
+	if config.RateLimiter != nil {
+		adaptiveRateLimiter, isAdaptive := config.RateLimiter.(ratelimiter.AdaptiveRateLimiter)
+
+		// Feed 429 responses (including retries) back into the rate limiter, so all requests slow down and not just the rejected one.
+		httpClient.client.OnAfterResponse(func(_ *resty.Client, response *resty.Response) error {
+			switch {
+			case response.StatusCode() == http.StatusTooManyRequests:
+				config.RateLimiter.Throttle(ParseRetryAfter(response.Header(), time.Now()))
+			case isAdaptive && response.StatusCode() < http.StatusInternalServerError:
+				adaptiveRateLimiter.Success()
+			}
+
+			return nil
+		})
 	}
 
 	return httpClient, nil
 }
 
-func (client *HttpClient) request() *resty.Request {
-	if *client.rateLimiter != nil {
-		ctx := context.Background()
+// WithContext returns a shallow copy of the client bound to ctx.
+// Rate limiter waits, retries and in-flight requests made through the copy are aborted once ctx is done.
+// The copy shares the underlying rest client and rate limiter with the original client.
+func (client *HttpClient) WithContext(ctx context.Context) HttpClientInterface {
+	clone := *client
+	clone.ctx = ctx
 
-		err := (*client.rateLimiter).Wait(ctx)
-		if err != nil {
-			return client.client.R().SetError(err)
-		}
+	return &clone
+}
+
+func (client *HttpClient) context() context.Context {
+	if client.ctx == nil {
+		return context.Background()
+	}
+
+	return client.ctx
+}
+
+func (client *HttpClient) requestWithContext(ctx context.Context) (*resty.Request, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	waitStart := time.Now()
+
+	if err := client.waitForRateLimiter(ctx); err != nil {
+		return nil, err
+	}
+
+	client.usage.update(ctx, func(usage *EndpointUsage) { usage.RateLimiterWait += time.Since(waitStart) })
+
+	return client.client.R().SetContext(ctx).SetBasicAuth(client.ApiKey, client.ApiSecret), nil
+}
+
+// send sends a (write) request in a span (see startRequestSpan). In-flight GET requests are forgotten once it finishes.
+func (client *HttpClient) send(method string, path string, prepare func(*resty.Request) *resty.Request) error {
+	defer client.getRequests.forget()
+
+	ctx, span := startRequestSpan(client.context(), method, path)
+	ctx = withEndpoint(ctx, method, path)
+
+	req, err := client.requestWithContext(ctx)
+	if err != nil {
+		endRequestSpan(span, nil, err)
+
+		return err
 	}
 
-	return client.client.R().SetBasicAuth(client.ApiKey, client.ApiSecret)
+	start := time.Now()
+	result, err := prepare(req).Execute(method, path)
+	client.countRequest(ctx, start, result, err)
+	endRequestSpan(span, result, err)
+
+	return client.httpResult(result, err)
 }
 
 func (client *HttpClient) httpResult(response *resty.Response, err error) error {
@@ -65,64 +134,94 @@ func (client *HttpClient) httpResult(response *resty.Response, err error) error
 	}
 
 	if !response.IsSuccess() {
-		return &FailedResponseError{res: response}
+		return newFailedResponseError(response)
 	}
 
 	return nil
 }
 
 func (client *HttpClient) Post(path string, request any, response any) error {
-	req := client.request().SetBody(request)
-	if response != nil {
-		req = req.SetResult(response)
-	}
-
-	result, err := req.Post(path)
+	return client.send(resty.MethodPost, path, func(req *resty.Request) *resty.Request {
+		req = req.SetBody(request)
+		if response != nil {
+			req = req.SetResult(response)
+		}
 
-	return client.httpResult(result, err)
+		return req
+	})
 }
 
 func (client *HttpClient) Put(path string, request any, response any) error {
-	req := client.request().SetBody(request)
-	if response != nil {
-		req = req.SetResult(response)
-	}
-
-	result, err := req.Put(path)
+	return client.send(resty.MethodPut, path, func(req *resty.Request) *resty.Request {
+		req = req.SetBody(request)
+		if response != nil {
+			req = req.SetResult(response)
+		}
 
-	return client.httpResult(result, err)
+		return req
+	})
 }
 
+// Get sends a GET request, and decodes the JSON response into response (or copies it as is if response is a *string).
+// Identical concurrent GET requests (same path and params) are coalesced: a single request is sent and its response is shared.
 func (client *HttpClient) Get(path string, params map[string]string, response any) error {
-	request := client.request().SetQueryParams(params)
-
-	responseType := reflect.TypeOf(response)
+	ctx, span := startRequestSpan(client.context(), resty.MethodGet, path)
 
-	if responseType.Kind() == reflect.Ptr && responseType.Elem().Kind() == reflect.String {
-		responseStrPtr := response.(*string)
+	result, err := client.getRequests.do(ctx, coalesceKey(path, params), func(ctx context.Context) (*resty.Response, error) {
+		ctx = withEndpoint(ctx, resty.MethodGet, path)
 
-		result, err := request.Get(path)
-		if err == nil {
-			*responseStrPtr = string(result.Body())
+		request, err := client.requestWithContext(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		return client.httpResult(result, err)
-	} else {
-		return client.httpResult(request.SetResult(response).Get(path))
+		start := time.Now()
+		result, err := request.SetQueryParams(params).Get(path)
+		client.countRequest(ctx, start, result, err)
+
+		return result, err
+	})
+	endRequestSpan(span, result, err)
+
+	if err := client.httpResult(result, err); err != nil {
+		return err
 	}
+
+	if responseStrPtr, ok := response.(*string); ok {
+		*responseStrPtr = string(result.Body())
+
+		return nil
+	}
+
+	// Decode the same way resty does for a request with a result.
+	if result.StatusCode() == http.StatusNoContent || !resty.IsJSONType(result.Header().Get("Content-Type")) {
+		return nil
+	}
+
+	return client.client.JSONUnmarshal(result.Body(), response)
 }
 
-func (client *HttpClient) Delete(path string, params map[string]string) error {
-	result, err := client.request().SetQueryParams(params).Delete(path)
+// GetRequestStats returns the number of GET requests sent, and the number of GET requests that
+// were coalesced with identical in-flight requests (i.e. the number of requests saved).
+func (client *HttpClient) GetRequestStats() (sent int64, coalesced int64) {
+	return client.getRequests.stats()
+}
 
-	return client.httpResult(result, err)
+// Usage returns the usage of the env0 API by the client (and all its copies), by endpoint.
+func (client *HttpClient) Usage() UsageSummary {
+	return client.usage.summary()
 }
 
-func (client *HttpClient) Patch(path string, request any, response any) error {
-	result, err := client.request().
-		SetBody(request).
-		SetResult(response).
-		Patch(path)
+func (client *HttpClient) Delete(path string, params map[string]string) error {
+	return client.send(resty.MethodDelete, path, func(req *resty.Request) *resty.Request {
+		return req.SetQueryParams(params)
+	})
+}
 
-	return client.httpResult(result, err)
+func (client *HttpClient) Patch(path string, request any, response any) error {
+	return client.send(resty.MethodPatch, path, func(req *resty.Request) *resty.Request {
+		return req.
+			SetBody(request).
+			SetResult(response)
+	})
 }
@@ -10,6 +10,7 @@
 package http
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -108,3 +109,17 @@ func (mr *MockHttpClientInterfaceMockRecorder) Put(path, request, response any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockHttpClientInterface)(nil).Put), path, request, response)
 }
+
+// WithContext mocks base method.
+func (m *MockHttpClientInterface) WithContext(ctx context.Context) HttpClientInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret0, _ := ret[0].(HttpClientInterface)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext.
+func (mr *MockHttpClientInterfaceMockRecorder) WithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockHttpClientInterface)(nil).WithContext), ctx)
+}
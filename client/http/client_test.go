@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -251,4 +252,32 @@ var _ = Describe("Http Client", func() {
 			AssertError(err)
 		})
 	})
+
+	Describe("WithContext", func() {
+		It("should send the request with the bound context", func() {
+			type ctxKey struct{}
+
+			ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+			var result ResponseType
+
+			err := httpclient.WithContext(ctx).Get(successURI, nil, &result)
+
+			AssertHttpCall("GET", successUrl)
+			AssertNoError(err)
+			Expect(httpRequest.Context().Value(ctxKey{})).To(Equal("value"))
+		})
+
+		It("should not send the request when the context is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			var result ResponseType
+
+			err := httpclient.WithContext(ctx).Get(successURI, nil, &result)
+
+			Expect(err).To(Equal(context.Canceled))
+			Expect(httpmock.GetTotalCallCount()).To(BeZero())
+		})
+	})
 })
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// inflightGet is a GET request shared by all the concurrent callers that requested it.
+type inflightGet struct {
+	done     chan struct{}
+	response *resty.Response
+	err      error
+	// The number of callers still waiting for the response. The request is canceled when all of them give up.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// getCoalescer deduplicates identical (same path and query params) in-flight GET requests.
+// Concurrent callers share a single response, which saves rate limit budget when many resources read the same endpoint.
+// It is shared by an HttpClient and all its copies (see WithContext).
+type getCoalescer struct {
+	mu       sync.Mutex
+	inflight map[string]*inflightGet
+
+	sent      atomic.Int64
+	coalesced atomic.Int64
+}
+
+func newGetCoalescer() *getCoalescer {
+	return &getCoalescer{inflight: make(map[string]*inflightGet)}
+}
+
+func coalesceKey(path string, params map[string]string) string {
+	values := url.Values{}
+	for key, value := range params {
+		values.Set(key, value)
+	}
+
+	// Encode sorts by key.
+	return path + "?" + values.Encode()
+}
+
+// do returns the response of an identical in-flight request, or sends the request (using send) if there is none.
+// The request is sent with a context that is canceled only after all callers waiting for it are canceled.
+func (c *getCoalescer) do(ctx context.Context, key string, send func(ctx context.Context) (*resty.Response, error)) (*resty.Response, error) {
+	c.mu.Lock()
+
+	call, ok := c.inflight[key]
+	if ok {
+		call.waiters++
+		c.mu.Unlock()
+
+		coalesced := c.coalesced.Add(1)
+		tflog.Debug(ctx, "Coalesced a GET request with an identical in-flight request", map[string]any{"request": key, "coalesced requests": coalesced, "sent requests": c.sent.Load()})
+	} else {
+		requestCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+		call = &inflightGet{
+			done:    make(chan struct{}),
+			waiters: 1,
+			cancel:  cancel,
+		}
+		c.inflight[key] = call
+		c.mu.Unlock()
+
+		c.sent.Add(1)
+
+		go c.send(requestCtx, key, call, send)
+	}
+
+	select {
+	case <-call.done:
+		return call.response, call.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+
+			// Callers that arrive later send a new request instead of joining the canceled one.
+			if c.inflight[key] == call {
+				delete(c.inflight, key)
+			}
+		}
+
+		return nil, ctx.Err()
+	}
+}
+
+func (c *getCoalescer) send(ctx context.Context, key string, call *inflightGet, send func(ctx context.Context) (*resty.Response, error)) {
+	defer call.cancel()
+
+	call.response, call.err = send(ctx)
+
+	c.mu.Lock()
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
+	}
+	c.mu.Unlock()
+
+	close(call.done)
+}
+
+// forget makes GET requests that are sent from now on not join in-flight requests, which may have read data from before
+// a write request. Like the read cache, every in-flight request is forgotten, since a write may change any read (e.g. a list).
+// Callers that already joined an in-flight request still get its response.
+func (c *getCoalescer) forget() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.inflight)
+}
+
+func (c *getCoalescer) stats() (sent int64, coalesced int64) {
+	return c.sent.Load(), c.coalesced.Load()
+}
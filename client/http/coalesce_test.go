@@ -0,0 +1,249 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Coalescing GET requests", func() {
+	const (
+		BaseUrl = "https://fake.env0.com"
+		Path    = "/projects"
+		Callers = 10
+	)
+
+	var (
+		httpclient *httpModule.HttpClient
+		// Responders block until release is closed, so requests stay in-flight.
+		release chan struct{}
+		started chan struct{}
+	)
+
+	BeforeEach(func() {
+		restClient := resty.New()
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		var err error
+
+		httpclient, err = httpModule.NewHttpClient(httpModule.HttpClientConfig{
+			ApiKey:      "key",
+			ApiSecret:   "secret",
+			ApiEndpoint: BaseUrl,
+			RestClient:  restClient,
+		})
+		Expect(err).To(BeNil())
+
+		release = make(chan struct{})
+		started = make(chan struct{}, 100)
+	})
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	getConcurrently := func(params map[string]string) ([]ResponseType, []error) {
+		var wg sync.WaitGroup
+
+		results := make([]ResponseType, Callers)
+		errs := make([]error, Callers)
+
+		for i := range Callers {
+			wg.Go(func() {
+				errs[i] = httpclient.Get(Path, params, &results[i])
+			})
+		}
+
+		// Let all the callers join before the response is sent.
+		Eventually(started).Should(Receive())
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+
+		wg.Wait()
+
+		return results, errs
+	}
+
+	It("should send a single request for identical concurrent requests", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+Path, func(req *http.Request) (*http.Response, error) {
+			started <- struct{}{}
+			<-release
+
+			return httpmock.NewJsonResponse(200, ResponseType{Id: 1, Name: "project"})
+		})
+
+		results, errs := getConcurrently(map[string]string{"organizationId": "org0", "a": "b"})
+
+		for i := range Callers {
+			Expect(errs[i]).To(BeNil())
+			Expect(results[i]).To(Equal(ResponseType{Id: 1, Name: "project"}))
+		}
+
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+
+		sent, coalesced := httpclient.GetRequestStats()
+		Expect(sent).To(Equal(int64(1)))
+		Expect(coalesced).To(Equal(int64(Callers - 1)))
+	})
+
+	It("should not join a request that started before a write request", func() {
+		calls := 0
+
+		httpmock.RegisterResponder("GET", BaseUrl+Path, func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				started <- struct{}{}
+				<-release
+
+				return httpmock.NewJsonResponse(200, ResponseType{Id: 1, Name: "before"})
+			}
+
+			return httpmock.NewJsonResponse(200, ResponseType{Id: 1, Name: "after"})
+		})
+		httpmock.RegisterResponder("POST", BaseUrl+Path, httpmock.NewJsonResponderOrPanic(200, ResponseType{Id: 1}))
+
+		var (
+			wg     sync.WaitGroup
+			before ResponseType
+		)
+
+		wg.Go(func() {
+			Expect(httpclient.Get(Path, nil, &before)).To(BeNil())
+		})
+
+		Eventually(started).Should(Receive())
+		Expect(httpclient.Post(Path, RequestBody{Message: "update"}, nil)).To(BeNil())
+
+		var after ResponseType
+		Expect(httpclient.Get(Path, nil, &after)).To(BeNil())
+		Expect(after.Name).To(Equal("after"))
+
+		close(release)
+		wg.Wait()
+
+		Expect(before.Name).To(Equal("before"))
+		Expect(httpmock.GetCallCountInfo()["GET "+BaseUrl+Path]).To(Equal(2))
+	})
+
+	It("should not coalesce requests with different params", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+Path, httpmock.NewJsonResponderOrPanic(200, ResponseType{Id: 1}))
+
+		var wg sync.WaitGroup
+
+		for _, organizationId := range []string{"org0", "org1"} {
+			wg.Go(func() {
+				var result ResponseType
+				Expect(httpclient.Get(Path, map[string]string{"organizationId": organizationId}, &result)).To(BeNil())
+			})
+		}
+
+		wg.Wait()
+
+		Expect(httpmock.GetTotalCallCount()).To(Equal(2))
+	})
+
+	It("should not coalesce sequential requests", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+Path, httpmock.NewJsonResponderOrPanic(200, ResponseType{Id: 1}))
+
+		for range 3 {
+			var result ResponseType
+			Expect(httpclient.Get(Path, nil, &result)).To(BeNil())
+		}
+
+		Expect(httpmock.GetTotalCallCount()).To(Equal(3))
+	})
+
+	It("should share a failed response", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+Path, func(req *http.Request) (*http.Response, error) {
+			started <- struct{}{}
+			<-release
+
+			return httpmock.NewStringResponse(500, `{"message":"failed"}`), nil
+		})
+
+		_, errs := getConcurrently(nil)
+
+		for i := range Callers {
+			var failedResponseError *httpModule.FailedResponseError
+			Expect(errors.As(errs[i], &failedResponseError)).To(BeTrue())
+			Expect(failedResponseError.Message).To(Equal("failed"))
+		}
+
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+	})
+
+	It("should not fail the other callers when one caller is canceled", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+Path, func(req *http.Request) (*http.Response, error) {
+			started <- struct{}{}
+			<-release
+
+			return httpmock.NewJsonResponse(200, ResponseType{Id: 1})
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		canceledErr := make(chan error)
+
+		go func() {
+			var result ResponseType
+			canceledErr <- httpclient.WithContext(ctx).Get(Path, nil, &result)
+		}()
+
+		Eventually(started).Should(Receive())
+
+		otherErr := make(chan error)
+
+		go func() {
+			var result ResponseType
+			otherErr <- httpclient.Get(Path, nil, &result)
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+		Eventually(canceledErr).Should(Receive(Equal(context.Canceled)))
+
+		close(release)
+		Eventually(otherErr).Should(Receive(BeNil()))
+
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+	})
+
+	It("should cancel the request when all callers are canceled", func() {
+		requestCanceled := make(chan struct{})
+
+		httpmock.RegisterResponder("GET", BaseUrl+Path, func(req *http.Request) (*http.Response, error) {
+			started <- struct{}{}
+			<-req.Context().Done()
+			close(requestCanceled)
+
+			return nil, req.Context().Err()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		errs := make(chan error, 2)
+
+		for range 2 {
+			go func() {
+				var result ResponseType
+				errs <- httpclient.WithContext(ctx).Get(Path, nil, &result)
+			}()
+		}
+
+		Eventually(started).Should(Receive())
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		Eventually(errs).Should(Receive(Equal(context.Canceled)))
+		Eventually(errs).Should(Receive(Equal(context.Canceled)))
+		Eventually(requestCanceled).Should(BeClosed())
+	})
+})
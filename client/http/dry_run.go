@@ -0,0 +1,219 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DryRunHttpClient sends GET requests through the wrapped client, while POST, PUT, PATCH and DELETE requests are
+// logged (with secrets redacted) and not sent. Mutating requests are answered with synthesized responses:
+// the request body, with a generated id for created objects.
+// GET requests of objects that were synthesized (by path, or by an id in the path or params) are answered with the
+// synthesized object, as they don't exist in env0.
+type DryRunHttpClient struct {
+	client HttpClientInterface
+	ctx    context.Context
+	state  *dryRunState
+}
+
+// dryRunState is shared by a DryRunHttpClient and all its copies.
+type dryRunState struct {
+	mu sync.Mutex
+	// ids are the generated ids of the synthesized objects.
+	ids map[string]bool
+	// objects are the synthesized objects (JSON), by path (E.g. "/projects/<id>").
+	objects map[string][]byte
+}
+
+func NewDryRunHttpClient(client HttpClientInterface) *DryRunHttpClient {
+	return &DryRunHttpClient{
+		client: client,
+		state: &dryRunState{
+			ids:     map[string]bool{},
+			objects: map[string][]byte{},
+		},
+	}
+}
+
+func (client *DryRunHttpClient) WithContext(ctx context.Context) HttpClientInterface {
+	clone := *client
+	clone.ctx = ctx
+	clone.client = client.client.WithContext(ctx)
+
+	return &clone
+}
+
+func (client *DryRunHttpClient) context() context.Context {
+	if client.ctx == nil {
+		return context.Background()
+	}
+
+	return client.ctx
+}
+
+func (client *DryRunHttpClient) log(method string, path string, fields map[string]any) {
+	fields["method"] = method
+	fields["path"] = path
+
+	tflog.Info(client.context(), "Dry run: not sending the request", fields)
+}
+
+// isSynthesized returns true if the path or the params refer to a synthesized object.
+func (client *DryRunHttpClient) isSynthesized(path string, params map[string]string) bool {
+	client.state.mu.Lock()
+	defer client.state.mu.Unlock()
+
+	if _, ok := client.state.objects[path]; ok {
+		return true
+	}
+
+	for segment := range strings.SplitSeq(path, "/") {
+		if client.state.ids[segment] {
+			return true
+		}
+	}
+
+	for _, value := range params {
+		if client.state.ids[value] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (client *DryRunHttpClient) Get(path string, params map[string]string, response any) error {
+	if !client.isSynthesized(path, params) {
+		return client.client.Get(path, params, response)
+	}
+
+	tflog.Info(client.context(), "Dry run: answering a GET request of a synthesized object", map[string]any{"path": path, "params": params})
+
+	client.state.mu.Lock()
+	object := client.state.objects[path]
+	client.state.mu.Unlock()
+
+	return decodeDryRunResponse(object, response)
+}
+
+func (client *DryRunHttpClient) Post(path string, request any, response any) error {
+	client.log("POST", path, map[string]any{"body": RedactBody(path, request)})
+
+	id := uuid.NewString()
+
+	object, err := synthesizeObject(nil, request, id)
+	if err != nil {
+		return err
+	}
+
+	client.state.mu.Lock()
+	client.state.ids[id] = true
+	client.state.objects[strings.TrimSuffix(path, "/")+"/"+id] = object
+	client.state.mu.Unlock()
+
+	return decodeDryRunResponse(object, response)
+}
+
+func (client *DryRunHttpClient) Put(path string, request any, response any) error {
+	client.log("PUT", path, map[string]any{"body": RedactBody(path, request)})
+
+	return client.update(path, request, response)
+}
+
+func (client *DryRunHttpClient) Patch(path string, request any, response any) error {
+	client.log("PATCH", path, map[string]any{"body": RedactBody(path, request)})
+
+	return client.update(path, request, response)
+}
+
+// update answers with the request body, merged into the synthesized object of the path (if there's one).
+func (client *DryRunHttpClient) update(path string, request any, response any) error {
+	client.state.mu.Lock()
+	defer client.state.mu.Unlock()
+
+	object, err := synthesizeObject(client.state.objects[path], request, "")
+	if err != nil {
+		return err
+	}
+
+	if _, ok := client.state.objects[path]; ok {
+		client.state.objects[path] = object
+	}
+
+	return decodeDryRunResponse(object, response)
+}
+
+func (client *DryRunHttpClient) Delete(path string, params map[string]string) error {
+	client.log("DELETE", path, map[string]any{"params": params})
+
+	client.state.mu.Lock()
+	delete(client.state.objects, path)
+	client.state.mu.Unlock()
+
+	return nil
+}
+
+// synthesizeObject returns the request body (JSON) merged into the existing object (if any).
+// If id is set and the body is a JSON object without an id, the id is added.
+func synthesizeObject(existing []byte, request any, id string) ([]byte, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Not a JSON object (E.g. a list), answered as is.
+	if !bytes.HasPrefix(body, []byte("{")) {
+		return body, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+
+	object := map[string]any{}
+	if len(existing) > 0 {
+		if err := json.Unmarshal(existing, &object); err != nil {
+			return nil, err
+		}
+	}
+
+	maps.Copy(object, fields)
+
+	if _, ok := object["id"]; !ok && id != "" {
+		object["id"] = id
+	}
+
+	return json.Marshal(object)
+}
+
+// decodeDryRunResponse decodes a synthesized object into response.
+// A synthesized object that doesn't match the response type (E.g. a request and response of different structures)
+// is decoded partially: fields that don't match are left empty.
+func decodeDryRunResponse(object []byte, response any) error {
+	if response == nil || len(object) == 0 {
+		return nil
+	}
+
+	if responseStrPtr, ok := response.(*string); ok {
+		*responseStrPtr = string(object)
+
+		return nil
+	}
+
+	var unmarshalTypeError *json.UnmarshalTypeError
+
+	if err := json.Unmarshal(object, response); err != nil && !errors.As(err, &unmarshalTypeError) {
+		return err
+	}
+
+	return nil
+}
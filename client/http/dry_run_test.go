@@ -0,0 +1,116 @@
+package http_test
+
+import (
+	"context"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry run", func() {
+	const BaseUrl = "https://fake.env0.com"
+
+	type Project struct {
+		Id          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	var dryRunClient httpModule.HttpClientInterface
+
+	BeforeEach(func() {
+		restClient := resty.New()
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		httpclient, err := httpModule.NewHttpClient(httpModule.HttpClientConfig{
+			ApiKey:      "key",
+			ApiSecret:   "secret",
+			ApiEndpoint: BaseUrl,
+			RestClient:  restClient,
+		})
+		Expect(err).To(BeNil())
+
+		dryRunClient = httpModule.NewDryRunHttpClient(httpclient).WithContext(context.Background())
+	})
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	It("should send GET requests", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+"/projects/project0", httpmock.NewJsonResponderOrPanic(200, Project{Id: "project0", Name: "name"}))
+
+		var project Project
+
+		Expect(dryRunClient.Get("/projects/project0", nil, &project)).To(Succeed())
+		Expect(project).To(Equal(Project{Id: "project0", Name: "name"}))
+		Expect(httpmock.GetTotalCallCount()).To(Equal(1))
+	})
+
+	It("should not send mutating requests", func() {
+		var project Project
+
+		Expect(dryRunClient.Put("/projects/project0", map[string]string{"name": "new name"}, &project)).To(Succeed())
+		Expect(project).To(Equal(Project{Name: "new name"}))
+
+		Expect(dryRunClient.Patch("/projects/project0", map[string]string{"description": "description"}, nil)).To(Succeed())
+		Expect(dryRunClient.Delete("/projects/project0", map[string]string{"force": "true"})).To(Succeed())
+		Expect(dryRunClient.Post("/projects", map[string]string{"name": "name"}, nil)).To(Succeed())
+
+		Expect(httpmock.GetTotalCallCount()).To(BeZero())
+	})
+
+	It("should synthesize created objects", func() {
+		var created Project
+
+		Expect(dryRunClient.Post("/projects", map[string]string{"name": "name", "description": "description"}, &created)).To(Succeed())
+		Expect(created.Id).NotTo(BeEmpty())
+		Expect(created.Name).To(Equal("name"))
+		Expect(created.Description).To(Equal("description"))
+
+		By("answering GET requests of the synthesized object")
+
+		var read Project
+
+		Expect(dryRunClient.Get("/projects/"+created.Id, nil, &read)).To(Succeed())
+		Expect(read).To(Equal(created))
+
+		By("updating the synthesized object")
+
+		Expect(dryRunClient.Put("/projects/"+created.Id, map[string]string{"name": "new name"}, nil)).To(Succeed())
+		Expect(dryRunClient.Get("/projects/"+created.Id, nil, &read)).To(Succeed())
+		Expect(read).To(Equal(Project{Id: created.Id, Name: "new name", Description: "description"}))
+
+		By("answering GET requests that refer to the synthesized object")
+
+		var environments []Project
+
+		Expect(dryRunClient.Get("/environments", map[string]string{"projectId": created.Id}, &environments)).To(Succeed())
+		Expect(environments).To(BeEmpty())
+
+		Expect(httpmock.GetTotalCallCount()).To(BeZero())
+	})
+
+	It("should decode a response that doesn't match the request partially", func() {
+		type Response struct {
+			Id   string `json:"id"`
+			Name int    `json:"name"`
+		}
+
+		var response Response
+
+		Expect(dryRunClient.Post("/teams", map[string]string{"name": "team"}, &response)).To(Succeed())
+		Expect(response.Id).NotTo(BeEmpty())
+		Expect(response.Name).To(BeZero())
+	})
+
+	It("should answer a list request as is", func() {
+		var response []string
+
+		Expect(dryRunClient.Post("/projects/assignments", []string{"a", "b"}, &response)).To(Succeed())
+		Expect(response).To(Equal([]string{"a", "b"}))
+	})
+})
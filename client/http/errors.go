@@ -1,19 +1,181 @@
 package http
 
-import "github.com/go-resty/resty/v2"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Response headers that may contain an id of the request, in order of precedence.
+var requestIdHeaders = []string{"X-Request-Id", "X-Amzn-RequestId", "X-Amzn-Trace-Id"}
+
+// Validation messages usually start with the name of the invalid property (e.g. "name must be a string").
+var validationMessageFieldRegex = regexp.MustCompile(`^(?:property )?([a-zA-Z][a-zA-Z0-9_]*(?:\.[a-zA-Z0-9_]+)*) (?:must|should|is|has|cannot|can not) `)
+
+// errorBody is the JSON body env0 responds with when a request fails.
+type errorBody struct {
+	Message   json.RawMessage `json:"message"` // Either a string or a list of strings.
+	Error     string          `json:"error"`
+	ErrorCode string          `json:"errorCode"`
+	Code      json.RawMessage `json:"code"`
+	Field     string          `json:"field"`
+	Property  string          `json:"property"`
+	RequestId string          `json:"requestId"`
+	TraceId   string          `json:"traceId"`
+}
 
 type FailedResponseError struct {
 	res *resty.Response
+
+	StatusCode int
+	// Message is the error message returned by the server (empty if the body isn't an env0 JSON error).
+	Message string
+	// ErrorCode is an application specific error code (if returned by the server).
+	ErrorCode string
+	// Field is the request body field the error refers to (if it can be inferred).
+	Field string
+	// RequestId identifies the request when contacting env0 support (if returned by the server).
+	RequestId string
+}
+
+// responseRequestId returns the request id header of the response (if returned by the server).
+func responseRequestId(res *resty.Response) string {
+	if res.RawResponse == nil {
+		return ""
+	}
+
+	for _, header := range requestIdHeaders {
+		if value := res.Header().Get(header); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func newFailedResponseError(res *resty.Response) *FailedResponseError {
+	e := &FailedResponseError{
+		res:        res,
+		StatusCode: res.StatusCode(),
+		RequestId:  responseRequestId(res),
+	}
+
+	var body errorBody
+	if err := json.Unmarshal(res.Body(), &body); err != nil {
+		return e
+	}
+
+	e.Message = parseErrorMessage(body.Message)
+	if e.Message == "" {
+		e.Message = body.Error
+	}
+
+	e.ErrorCode = body.ErrorCode
+	if e.ErrorCode == "" && len(body.Code) > 0 {
+		e.ErrorCode = strings.Trim(string(body.Code), `"`)
+	}
+
+	if requestId := firstNonEmpty(body.RequestId, body.TraceId); requestId != "" {
+		e.RequestId = requestId
+	}
+
+	e.Field = firstNonEmpty(body.Field, body.Property)
+	if e.Field == "" {
+		if match := validationMessageFieldRegex.FindStringSubmatch(e.Message); match != nil {
+			e.Field = match[1]
+		}
+	}
+
+	return e
+}
+
+func parseErrorMessage(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return message
+	}
+
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		return strings.Join(messages, ", ")
+	}
+
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
 }
 
 func (e *FailedResponseError) Error() string {
-	return e.res.Status() + ": " + string(e.res.Body())
+	message := e.Message
+	if message == "" {
+		message = string(e.res.Body())
+	}
+
+	if e.RequestId != "" {
+		return fmt.Sprintf("%s: %s (request id: %s)", e.res.Status(), message, e.RequestId)
+	}
+
+	return e.res.Status() + ": " + message
 }
 
 func (e *FailedResponseError) NotFound() bool {
-	return e.res.StatusCode() == 404
+	return e.StatusCode == http.StatusNotFound
 }
 
 func (e *FailedResponseError) BadRequest() bool {
-	return e.res.StatusCode() == 400
+	return e.StatusCode == http.StatusBadRequest
+}
+
+func (e *FailedResponseError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *FailedResponseError) Forbidden() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+func (e *FailedResponseError) Conflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+func (e *FailedResponseError) UnprocessableEntity() bool {
+	return e.StatusCode == http.StatusUnprocessableEntity
+}
+
+func (e *FailedResponseError) TooManyRequests() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsAmbiguousFailure returns true if a request that failed with err may have been processed by the server anyway.
+// E.g. a network error, or a gateway timeout after the server committed the request.
+// Context errors are not ambiguous: the request was either not sent, or the caller gave up on it.
+func IsAmbiguousFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var failedResponseError *FailedResponseError
+	if errors.As(err, &failedResponseError) {
+		return failedResponseError.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
 }
@@ -15,7 +15,5 @@ func NewMockFailedResponseError(statusCode int) error {
 		RawResponse: raw,
 	}
 
-	return &FailedResponseError{
-		res: res,
-	}
+	return newFailedResponseError(res)
 }
@@ -0,0 +1,145 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FailedResponseError", func() {
+	const (
+		BaseUrl = "https://fake.env0.com"
+		Path    = "/path/to/failure"
+	)
+
+	var httpclient *httpModule.HttpClient
+
+	BeforeEach(func() {
+		restClient := resty.New()
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		var err error
+
+		httpclient, err = httpModule.NewHttpClient(httpModule.HttpClientConfig{
+			ApiKey:      "key",
+			ApiSecret:   "secret",
+			ApiEndpoint: BaseUrl,
+			RestClient:  restClient,
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	failedResponse := func(statusCode int, body string, headers map[string]string) *httpModule.FailedResponseError {
+		httpmock.RegisterResponder("GET", BaseUrl+Path, func(req *http.Request) (*http.Response, error) {
+			response := httpmock.NewStringResponse(statusCode, body)
+			for key, value := range headers {
+				response.Header.Set(key, value)
+			}
+
+			return response, nil
+		})
+
+		var result map[string]any
+
+		err := httpclient.Get(Path, nil, &result)
+		Expect(err).ToNot(BeNil())
+
+		var failedResponseError *httpModule.FailedResponseError
+		Expect(errors.As(err, &failedResponseError)).To(BeTrue())
+
+		return failedResponseError
+	}
+
+	It("should keep the raw body when the body isn't a JSON error", func() {
+		err := failedResponse(500, "Very bad!", nil)
+
+		Expect(err.StatusCode).To(Equal(500))
+		Expect(err.Message).To(BeEmpty())
+		Expect(err.Error()).To(Equal("500: Very bad!"))
+	})
+
+	It("should parse a JSON error", func() {
+		err := failedResponse(409, `{"message":"project already exists","errorCode":"PROJECT_EXISTS","requestId":"req-123"}`, nil)
+
+		Expect(err.StatusCode).To(Equal(409))
+		Expect(err.Conflict()).To(BeTrue())
+		Expect(err.Message).To(Equal("project already exists"))
+		Expect(err.ErrorCode).To(Equal("PROJECT_EXISTS"))
+		Expect(err.RequestId).To(Equal("req-123"))
+		Expect(err.Field).To(BeEmpty())
+		Expect(err.Error()).To(Equal("409: project already exists (request id: req-123)"))
+	})
+
+	It("should join a list of validation messages and infer the field", func() {
+		err := failedResponse(400, `{"statusCode":400,"message":["name must be a string","name should not be empty"],"error":"Bad Request"}`, nil)
+
+		Expect(err.BadRequest()).To(BeTrue())
+		Expect(err.Message).To(Equal("name must be a string, name should not be empty"))
+		Expect(err.Field).To(Equal("name"))
+	})
+
+	It("should prefer an explicit field", func() {
+		err := failedResponse(422, `{"message":"invalid value","field":"projectId"}`, nil)
+
+		Expect(err.UnprocessableEntity()).To(BeTrue())
+		Expect(err.Field).To(Equal("projectId"))
+	})
+
+	It("should fall back to the error and code properties", func() {
+		err := failedResponse(403, `{"error":"Forbidden","code":1003}`, nil)
+
+		Expect(err.Forbidden()).To(BeTrue())
+		Expect(err.Message).To(Equal("Forbidden"))
+		Expect(err.ErrorCode).To(Equal("1003"))
+	})
+
+	It("should read the request id from the response headers", func() {
+		err := failedResponse(401, `{"message":"Unauthorized"}`, map[string]string{"X-Amzn-RequestId": "amzn-456"})
+
+		Expect(err.Unauthorized()).To(BeTrue())
+		Expect(err.RequestId).To(Equal("amzn-456"))
+	})
+
+	DescribeTable("status predicates",
+		func(statusCode int, predicate func(*httpModule.FailedResponseError) bool) {
+			err := httpModule.NewMockFailedResponseError(statusCode)
+
+			var failedResponseError *httpModule.FailedResponseError
+			Expect(errors.As(err, &failedResponseError)).To(BeTrue())
+			Expect(predicate(failedResponseError)).To(BeTrue())
+		},
+		Entry("not found", 404, (*httpModule.FailedResponseError).NotFound),
+		Entry("bad request", 400, (*httpModule.FailedResponseError).BadRequest),
+		Entry("unauthorized", 401, (*httpModule.FailedResponseError).Unauthorized),
+		Entry("forbidden", 403, (*httpModule.FailedResponseError).Forbidden),
+		Entry("conflict", 409, (*httpModule.FailedResponseError).Conflict),
+		Entry("unprocessable entity", 422, (*httpModule.FailedResponseError).UnprocessableEntity),
+		Entry("too many requests", 429, (*httpModule.FailedResponseError).TooManyRequests),
+	)
+
+	DescribeTable("ambiguous failures",
+		func(err error, expected bool) {
+			Expect(httpModule.IsAmbiguousFailure(err)).To(Equal(expected))
+		},
+		Entry("no error", nil, false),
+		Entry("network error", errors.New("connection reset by peer"), true),
+		Entry("server error", httpModule.NewMockFailedResponseError(500), true),
+		Entry("gateway timeout", httpModule.NewMockFailedResponseError(504), true),
+		Entry("client error", httpModule.NewMockFailedResponseError(400), false),
+		Entry("rate limited", httpModule.NewMockFailedResponseError(429), false),
+		Entry("canceled", context.Canceled, false),
+		Entry("deadline exceeded", fmt.Errorf("wait: %w", context.DeadlineExceeded), false),
+	)
+})
@@ -1,6 +1,10 @@
 package http_test
 
 import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	httpModule "github.com/env0/terraform-provider-env0/client/http"
@@ -55,10 +59,13 @@ var _ = Describe("SlidingWindow Rate Limiter", func() {
 		return client
 	}
 
+	var requestNumber atomic.Int64
+
 	makeRequest := func(client *httpModule.HttpClient) {
 		var response string
 
-		err := client.Get(TestEndpoint, nil, &response)
+		// Each request has unique params, so concurrent requests aren't coalesced.
+		err := client.Get(TestEndpoint, map[string]string{"request": strconv.FormatInt(requestNumber.Add(1), 10)}, &response)
 		Expect(err).To(BeNil())
 		Expect(response).To(Equal(SuccessResponse))
 	}
@@ -104,5 +111,87 @@ var _ = Describe("SlidingWindow Rate Limiter", func() {
 			callCount = httpmock.GetCallCountInfo()
 			Expect(callCount["GET "+BaseUrl+TestEndpoint]).To(Equal(maxConcurrentRequests * 2))
 		})
+
+		It("should abort a rate limiter wait when the context is canceled", func() {
+			httpClient = createClient(1, time.Minute)
+
+			makeRequest(httpClient)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			var response string
+
+			start := time.Now()
+			err := httpClient.WithContext(ctx).Get(TestEndpoint, nil, &response)
+
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+
+			callCount := httpmock.GetCallCountInfo()
+			Expect(callCount["GET "+BaseUrl+TestEndpoint]).To(Equal(1))
+		})
+
+		It("should throttle subsequent requests after a 429 response", func() {
+			const throttledEndpoint = "/throttled"
+
+			httpmock.RegisterResponder("GET", BaseUrl+throttledEndpoint, func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusTooManyRequests, "")
+				res.Header.Set("Retry-After", "1")
+
+				return res, nil
+			})
+
+			httpClient = createClient(10, time.Minute)
+
+			var response string
+
+			err := httpClient.Get(throttledEndpoint, nil, &response)
+			Expect(err).ToNot(BeNil())
+
+			go makeRequest(httpClient)
+
+			time.Sleep(50 * time.Millisecond)
+
+			callCount := httpmock.GetCallCountInfo()
+			Expect(callCount["GET "+BaseUrl+TestEndpoint]).To(Equal(0))
+
+			time.Sleep(time.Second)
+
+			callCount = httpmock.GetCallCountInfo()
+			Expect(callCount["GET "+BaseUrl+TestEndpoint]).To(Equal(1))
+		})
+
+		It("should adjust an adaptive rate limiter according to the responses", func() {
+			const throttledEndpoint = "/throttled"
+
+			httpmock.RegisterResponder("GET", BaseUrl+throttledEndpoint, httpmock.NewStringResponder(http.StatusTooManyRequests, ""))
+
+			rateLimiter := ratelimiter.NewTokenBucketLimiter(6000, time.Minute, 100)
+
+			client, err := httpModule.NewHttpClient(httpModule.HttpClientConfig{
+				ApiKey:      ApiKey,
+				ApiSecret:   ApiSecret,
+				ApiEndpoint: BaseUrl,
+				UserAgent:   UserAgent,
+				RestClient:  restClient,
+				RateLimiter: rateLimiter,
+			})
+			Expect(err).To(BeNil())
+
+			var response string
+
+			Expect(client.Get(throttledEndpoint, nil, &response)).ToNot(BeNil())
+
+			perWindow, _ := rateLimiter.Budget()
+			Expect(perWindow).To(Equal(3000))
+
+			for range 10 {
+				makeRequest(client)
+			}
+
+			perWindow, _ = rateLimiter.Budget()
+			Expect(perWindow).To(Equal(3300))
+		})
 	})
 })
@@ -1,8 +1,24 @@
 package ratelimiter
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type RateLimiter interface {
 	Allow() bool
 	Wait(ctx context.Context) error
+	// Throttle reports that the server rejected a request with 429 Too Many Requests.
+	// retryAfter is the wait time requested by the server (0 if unknown, in which case the limiter picks the pause).
+	// No new requests are allowed until it passes.
+	Throttle(retryAfter time.Duration)
+}
+
+// AdaptiveRateLimiter is a RateLimiter that adjusts its rate according to the server responses.
+type AdaptiveRateLimiter interface {
+	RateLimiter
+	// Success reports that a request was accepted by the server.
+	Success()
+	// Budget returns the current number of requests allowed per window, and the number of requests that can be made immediately.
+	Budget() (perWindow int, available int)
 }
@@ -12,6 +12,7 @@ type SlidingWindowLimiter struct {
 	maxRequests int
 	window      time.Duration
 	requests    []time.Time
+	pausedUntil time.Time
 	mu          sync.Mutex
 }
 
@@ -38,6 +39,10 @@ func (l *SlidingWindowLimiter) Allow() bool {
 	now := time.Now()
 	l.cleanup(now)
 
+	if now.Before(l.pausedUntil) {
+		return false
+	}
+
 	if len(l.requests) < l.maxRequests {
 		l.requests = append(l.requests, now)
 
@@ -77,6 +82,27 @@ func (l *SlidingWindowLimiter) Wait(ctx context.Context) error {
 	}
 }
 
+// Throttle pauses all requests until retryAfter passes. If retryAfter is unknown (0), requests aren't paused.
+// Instead, one slot of the window is taken away (as if another request was made), so the next requests slow
+// down only if the window is full. The rejected request itself is delayed by the retry backoff.
+func (l *SlidingWindowLimiter) Throttle(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.cleanup(now)
+
+	if retryAfter <= 0 {
+		l.requests = append(l.requests, now)
+
+		return
+	}
+
+	if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
 // cleanup removes expired requests from the sliding window
 func (l *SlidingWindowLimiter) cleanup(now time.Time) {
 	cutoff := now.Add(-l.window)
@@ -101,6 +127,10 @@ func (l *SlidingWindowLimiter) nextAvailable() time.Duration {
 	now := time.Now()
 	l.cleanup(now)
 
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
 	if len(l.requests) < l.maxRequests {
 		return 0
 	}
@@ -199,6 +199,55 @@ var _ = Describe("SlidingWindowLimiter", func() {
 		})
 	})
 
+	Describe("Throttle", func() {
+		It("should block requests until the retry after duration passes", func() {
+			limiter = NewSlidingWindowLimiter(10, time.Second)
+
+			limiter.Throttle(100 * time.Millisecond)
+
+			Expect(limiter.Allow()).To(BeFalse())
+
+			start := time.Now()
+			err := limiter.Wait(context.Background())
+			duration := time.Since(start)
+
+			Expect(err).To(BeNil())
+			Expect(duration).To(BeNumerically(">=", 90*time.Millisecond))
+			Expect(duration).To(BeNumerically("<", 200*time.Millisecond))
+		})
+
+		It("should not shorten an existing pause", func() {
+			limiter = NewSlidingWindowLimiter(10, time.Second)
+
+			limiter.Throttle(time.Second)
+			pausedUntil := limiter.pausedUntil
+
+			limiter.Throttle(10 * time.Millisecond)
+
+			Expect(limiter.pausedUntil).To(Equal(pausedUntil))
+		})
+
+		It("should take one slot of the window when the retry after is unknown", func() {
+			limiter = NewSlidingWindowLimiter(3, time.Second)
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			limiter.Throttle(0)
+
+			Expect(limiter.pausedUntil.IsZero()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeFalse())
+		})
+
+		It("should not pause requests when the retry after is unknown and the window has free slots", func() {
+			limiter = NewSlidingWindowLimiter(10, time.Second)
+
+			limiter.Throttle(0)
+
+			Expect(limiter.nextAvailable()).To(BeZero())
+		})
+	})
+
 	Describe("Concurrent Access", func() {
 		BeforeEach(func() {
 			limiter = NewSlidingWindowLimiter(10, time.Second)
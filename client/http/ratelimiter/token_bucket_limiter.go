@@ -0,0 +1,207 @@
+package ratelimiter
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// On a 429 response the rate is multiplied by this factor.
+	decreaseFactor = 0.5
+	// The rate never decreases below this fraction of the max rate.
+	minRateFraction = 0.05
+	// After this many consecutive successful requests the rate is increased by increaseFraction of the max rate.
+	successesPerIncrease = 10
+	increaseFraction     = 0.05
+)
+
+// TokenBucketLimiter implements adaptive token bucket rate limiting.
+// The bucket holds up to burst tokens, and is refilled at the current rate. Each request consumes a token.
+// The rate is adjusted using AIMD (additive increase / multiplicative decrease):
+// it's halved whenever the server responds with 429 and slowly increased back (up to the max rate) on sustained success.
+// Waiters are served in FIFO order.
+type TokenBucketLimiter struct {
+	window      time.Duration
+	burst       float64
+	maxRate     float64 // tokens per second
+	minRate     float64
+	rate        float64
+	tokens      float64
+	successes   int
+	last        time.Time
+	pausedUntil time.Time
+	mu          sync.Mutex
+}
+
+// NewTokenBucketLimiter creates a new adaptive token bucket rate limiter.
+// maxRequests: maximum number of requests allowed per window (the rate never increases above it)
+// window: time window duration
+// burst: maximum number of requests that may be made at once
+//
+// Example: NewTokenBucketLimiter(1000, time.Minute, 50) allows bursts of 50 requests, and up to 1000 requests per minute.
+func NewTokenBucketLimiter(maxRequests int, window time.Duration, burst int) *TokenBucketLimiter {
+	maxRate := float64(maxRequests) / window.Seconds()
+
+	return &TokenBucketLimiter{
+		window:  window,
+		burst:   float64(burst),
+		maxRate: maxRate,
+		minRate: maxRate * minRateFraction,
+		rate:    maxRate,
+		tokens:  float64(burst),
+		last:    time.Now(),
+	}
+}
+
+// Allow returns true if a request can be made immediately.
+// If true, a token is consumed.
+// Allow never takes a token that is already reserved by a waiter.
+func (l *TokenBucketLimiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	if now.Before(l.pausedUntil) || l.tokens < 1 {
+		return false
+	}
+
+	l.tokens--
+
+	return true
+}
+
+// Wait blocks until a request can be made.
+// Returns an error if the context is canceled or times out.
+// A token is reserved when Wait is called, so concurrent waiters are released in the order they called Wait.
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	for delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+			// The server may have asked to slow down while waiting.
+			delay = l.pauseRemaining()
+		case <-ctx.Done():
+			timer.Stop()
+			l.cancelReservation()
+
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Throttle halves the rate (down to the minimum rate), drops the available burst and
+// pauses all requests until retryAfter passes.
+func (l *TokenBucketLimiter) Throttle(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	l.rate = math.Max(l.minRate, l.rate*decreaseFactor)
+	l.tokens = math.Min(l.tokens, 0)
+	l.successes = 0
+
+	if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// Success reports that a request was accepted by the server.
+// Every successesPerIncrease consecutive successes increase the rate (up to the max rate).
+func (l *TokenBucketLimiter) Success() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate >= l.maxRate {
+		return
+	}
+
+	l.successes++
+	if l.successes < successesPerIncrease {
+		return
+	}
+
+	l.refill(time.Now())
+
+	l.successes = 0
+	l.rate = math.Min(l.maxRate, l.rate+l.maxRate*increaseFraction)
+}
+
+// Budget returns the current number of requests allowed per window, and
+// the number of requests that can be made immediately.
+func (l *TokenBucketLimiter) Budget() (perWindow int, available int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	perWindow = int(l.rate * l.window.Seconds())
+
+	if !now.Before(l.pausedUntil) && l.tokens >= 1 {
+		available = int(l.tokens)
+	}
+
+	return perWindow, available
+}
+
+// refill adds the tokens accumulated since the last refill. Tokens don't accumulate while paused.
+func (l *TokenBucketLimiter) refill(now time.Time) {
+	from := l.last
+	if l.pausedUntil.After(from) {
+		from = l.pausedUntil
+	}
+
+	if now.After(from) {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(from).Seconds()*l.rate)
+	}
+
+	if now.After(l.last) {
+		l.last = now
+	}
+}
+
+// reserve consumes a token (possibly going into debt) and returns how long to wait before using it.
+func (l *TokenBucketLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	l.tokens--
+
+	start := now
+	if l.pausedUntil.After(start) {
+		start = l.pausedUntil
+	}
+
+	if l.tokens < 0 {
+		start = start.Add(time.Duration(-l.tokens / l.rate * float64(time.Second)))
+	}
+
+	return start.Sub(now)
+}
+
+func (l *TokenBucketLimiter) cancelReservation() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+func (l *TokenBucketLimiter) pauseRemaining() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return time.Until(l.pausedUntil)
+}
@@ -0,0 +1,352 @@
+package ratelimiter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenBucketLimiter", func() {
+	var limiter *TokenBucketLimiter
+
+	Describe("NewTokenBucketLimiter", func() {
+		It("should create a new limiter with correct configuration", func() {
+			limiter = NewTokenBucketLimiter(600, time.Minute, 20)
+
+			Expect(limiter).ToNot(BeNil())
+			Expect(limiter.maxRate).To(Equal(10.0))
+			Expect(limiter.rate).To(Equal(10.0))
+			Expect(limiter.minRate).To(Equal(0.5))
+			Expect(limiter.burst).To(Equal(20.0))
+			Expect(limiter.tokens).To(Equal(20.0))
+		})
+
+		It("should implement AdaptiveRateLimiter", func() {
+			var rl RateLimiter = NewTokenBucketLimiter(10, time.Second, 1)
+
+			_, ok := rl.(AdaptiveRateLimiter)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Describe("Allow", func() {
+		It("should allow a burst up to the bucket size", func() {
+			limiter = NewTokenBucketLimiter(10, time.Minute, 3)
+
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeFalse())
+		})
+
+		It("should refill tokens at the rate", func() {
+			// 20 requests per second - a token every 50ms.
+			limiter = NewTokenBucketLimiter(20, time.Second, 1)
+
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeFalse())
+
+			time.Sleep(60 * time.Millisecond)
+
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeFalse())
+		})
+
+		It("should not refill above the bucket size", func() {
+			limiter = NewTokenBucketLimiter(1000, time.Second, 2)
+
+			time.Sleep(20 * time.Millisecond)
+
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeTrue())
+			Expect(limiter.Allow()).To(BeFalse())
+		})
+	})
+
+	Describe("Wait", func() {
+		It("should return immediately when tokens are available", func() {
+			limiter = NewTokenBucketLimiter(10, time.Second, 5)
+
+			start := time.Now()
+
+			for range 5 {
+				Expect(limiter.Wait(context.Background())).To(BeNil())
+			}
+
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Millisecond))
+		})
+
+		It("should wait for a token when the bucket is empty", func() {
+			// 10 requests per second - a token every 100ms.
+			limiter = NewTokenBucketLimiter(10, time.Second, 1)
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			start := time.Now()
+			err := limiter.Wait(context.Background())
+			duration := time.Since(start)
+
+			Expect(err).To(BeNil())
+			Expect(duration).To(BeNumerically(">=", 90*time.Millisecond))
+			Expect(duration).To(BeNumerically("<", 200*time.Millisecond))
+		})
+
+		It("should respect context cancellation and give back the reservation", func() {
+			limiter = NewTokenBucketLimiter(10, time.Second, 1)
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			err := limiter.Wait(ctx)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+
+			// The canceled reservation shouldn't delay the next waiter.
+			start := time.Now()
+			Expect(limiter.Wait(context.Background())).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically("<", 120*time.Millisecond))
+		})
+
+		It("should release concurrent waiters in FIFO order", func() {
+			// 50 requests per second - a token every 20ms.
+			limiter = NewTokenBucketLimiter(50, time.Second, 1)
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			const waiters = 5
+
+			var (
+				wg    sync.WaitGroup
+				mu    sync.Mutex
+				order []int
+			)
+
+			for i := range waiters {
+				wg.Add(1)
+
+				go func(idx int) {
+					defer wg.Done()
+
+					Expect(limiter.Wait(context.Background())).To(BeNil())
+
+					mu.Lock()
+					order = append(order, idx)
+					mu.Unlock()
+				}(i)
+
+				// Make sure the waiters call Wait in order.
+				time.Sleep(2 * time.Millisecond)
+			}
+
+			wg.Wait()
+
+			Expect(order).To(Equal([]int{0, 1, 2, 3, 4}))
+		})
+
+		It("should not let Allow jump ahead of waiters", func() {
+			limiter = NewTokenBucketLimiter(10, time.Second, 1)
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+
+				Expect(limiter.Wait(context.Background())).To(BeNil())
+			}()
+
+			// By now the next token was refilled, but it is reserved by the waiter.
+			time.Sleep(115 * time.Millisecond)
+			Expect(limiter.Allow()).To(BeFalse())
+
+			<-done
+		})
+	})
+
+	Describe("Throttle", func() {
+		It("should halve the rate down to the minimum rate", func() {
+			limiter = NewTokenBucketLimiter(100, time.Second, 10)
+
+			limiter.Throttle(0)
+			Expect(limiter.rate).To(Equal(50.0))
+
+			limiter.Throttle(0)
+			Expect(limiter.rate).To(Equal(25.0))
+
+			for range 10 {
+				limiter.Throttle(0)
+			}
+
+			Expect(limiter.rate).To(Equal(5.0))
+		})
+
+		It("should drop the available burst", func() {
+			limiter = NewTokenBucketLimiter(10, time.Minute, 10)
+
+			limiter.Throttle(0)
+
+			Expect(limiter.Allow()).To(BeFalse())
+		})
+
+		It("should pause waiters until the retry after duration passes", func() {
+			limiter = NewTokenBucketLimiter(1000, time.Second, 10)
+
+			limiter.Throttle(100 * time.Millisecond)
+
+			start := time.Now()
+			err := limiter.Wait(context.Background())
+			duration := time.Since(start)
+
+			Expect(err).To(BeNil())
+			Expect(duration).To(BeNumerically(">=", 100*time.Millisecond))
+			Expect(duration).To(BeNumerically("<", 200*time.Millisecond))
+		})
+
+		It("should pause waiters that are already waiting", func() {
+			// 20 requests per second - a token every 50ms.
+			limiter = NewTokenBucketLimiter(20, time.Second, 1)
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			var duration time.Duration
+
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+
+				start := time.Now()
+				Expect(limiter.Wait(context.Background())).To(BeNil())
+				duration = time.Since(start)
+			}()
+
+			time.Sleep(10 * time.Millisecond)
+			limiter.Throttle(150 * time.Millisecond)
+
+			<-done
+
+			Expect(duration).To(BeNumerically(">=", 150*time.Millisecond))
+		})
+	})
+
+	Describe("Success", func() {
+		It("should increase the rate after sustained success", func() {
+			limiter = NewTokenBucketLimiter(100, time.Second, 10)
+
+			limiter.Throttle(0)
+			Expect(limiter.rate).To(Equal(50.0))
+
+			for range successesPerIncrease - 1 {
+				limiter.Success()
+			}
+
+			Expect(limiter.rate).To(Equal(50.0))
+
+			limiter.Success()
+			Expect(limiter.rate).To(Equal(55.0))
+		})
+
+		It("should not increase the rate above the max rate", func() {
+			limiter = NewTokenBucketLimiter(100, time.Second, 10)
+
+			limiter.Throttle(0)
+
+			for range successesPerIncrease * 100 {
+				limiter.Success()
+			}
+
+			Expect(limiter.rate).To(Equal(100.0))
+		})
+
+		It("should restart counting successes after a throttle", func() {
+			limiter = NewTokenBucketLimiter(100, time.Second, 10)
+
+			limiter.Throttle(0)
+
+			for range successesPerIncrease - 1 {
+				limiter.Success()
+			}
+
+			limiter.Throttle(0)
+			limiter.Success()
+
+			Expect(limiter.rate).To(Equal(25.0))
+		})
+	})
+
+	Describe("Budget", func() {
+		It("should report the current budget", func() {
+			limiter = NewTokenBucketLimiter(600, time.Minute, 5)
+
+			perWindow, available := limiter.Budget()
+			Expect(perWindow).To(Equal(600))
+			Expect(available).To(Equal(5))
+
+			Expect(limiter.Allow()).To(BeTrue())
+
+			limiter.Throttle(time.Minute)
+
+			perWindow, available = limiter.Budget()
+			Expect(perWindow).To(Equal(300))
+			Expect(available).To(Equal(0))
+		})
+	})
+
+	Describe("Concurrent Access", func() {
+		It("should be safe for concurrent Allow calls", func() {
+			limiter = NewTokenBucketLimiter(10, time.Hour, 10)
+
+			var wg sync.WaitGroup
+
+			successCount := int32(0)
+
+			for range 100 {
+				wg.Go(func() {
+					if limiter.Allow() {
+						atomic.AddInt32(&successCount, 1)
+					}
+				})
+			}
+
+			wg.Wait()
+
+			Expect(successCount).To(Equal(int32(10)))
+		})
+
+		It("should be safe for concurrent Wait, Throttle and Success calls", func() {
+			limiter = NewTokenBucketLimiter(1000, time.Second, 5)
+
+			var wg sync.WaitGroup
+
+			errors := make([]error, 20)
+
+			for i := range 20 {
+				wg.Add(1)
+
+				go func(idx int) {
+					defer wg.Done()
+
+					errors[idx] = limiter.Wait(context.Background())
+
+					if idx%5 == 0 {
+						limiter.Throttle(time.Millisecond)
+					} else {
+						limiter.Success()
+					}
+				}(i)
+			}
+
+			wg.Wait()
+
+			for i := range 20 {
+				Expect(errors[i]).To(BeNil())
+			}
+		})
+	})
+})
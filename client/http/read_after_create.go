@@ -0,0 +1,93 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ReadAfterCreate tracks the objects created by a rest client, to retry reads that don't find an object that was just created.
+// The env0 API is eventually consistent: a read right after a create may return 404 (Not Found) for a short while.
+// It's safe for concurrent use.
+type ReadAfterCreate struct {
+	mu     sync.Mutex
+	window time.Duration
+	// created maps the ids of the created objects to their creation time.
+	created map[string]time.Time
+}
+
+// NewReadAfterCreate returns a ReadAfterCreate that retries reads up to window after the object was created.
+// A zero window disables the retries.
+func NewReadAfterCreate(window time.Duration) *ReadAfterCreate {
+	return &ReadAfterCreate{window: window, created: map[string]time.Time{}}
+}
+
+// Register tracks the objects created (by POST requests) by the rest client.
+func (c *ReadAfterCreate) Register(restClient *resty.Client) {
+	if c.window <= 0 {
+		return
+	}
+
+	restClient.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		if r.Request.Method != resty.MethodPost || !r.IsSuccess() {
+			return nil
+		}
+
+		var created struct {
+			Id string `json:"id"`
+		}
+
+		if err := json.Unmarshal(r.Body(), &created); err == nil && created.Id != "" {
+			c.add(created.Id, time.Now())
+		}
+
+		return nil
+	})
+}
+
+func (c *ReadAfterCreate) add(id string, createdAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Forget the objects that are out of the window.
+	for createdId, t := range c.created {
+		if createdAt.Sub(t) > c.window {
+			delete(c.created, createdId)
+		}
+	}
+
+	c.created[id] = createdAt
+}
+
+// ShouldRetry returns true if the response is a 404 (Not Found) response to a GET request that refers to an object
+// (in its path or query params) that was created within the window.
+func (c *ReadAfterCreate) ShouldRetry(r *resty.Response) bool {
+	if c.window <= 0 || r.StatusCode() != http.StatusNotFound || r.Request.Method != resty.MethodGet || r.Request.RawRequest == nil {
+		return false
+	}
+
+	url := r.Request.RawRequest.URL
+	refs := strings.Split(url.Path, "/")
+
+	for _, values := range url.Query() {
+		refs = append(refs, values...)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	for id, createdAt := range c.created {
+		if now.Sub(createdAt) <= c.window && slices.Contains(refs, id) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package http_test
+
+import (
+	"net/http"
+	"time"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadAfterCreate", func() {
+	const BaseUrl = "https://fake.env0.com"
+
+	var (
+		restClient      *resty.Client
+		readAfterCreate *httpModule.ReadAfterCreate
+	)
+
+	setup := func(window time.Duration) {
+		restClient = resty.New().SetBaseURL(BaseUrl)
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		readAfterCreate = httpModule.NewReadAfterCreate(window)
+		readAfterCreate.Register(restClient)
+
+		httpmock.RegisterResponder("POST", BaseUrl+"/environments", httpmock.NewStringResponder(http.StatusOK, `{"id": "env0"}`))
+		httpmock.RegisterResponder("POST", BaseUrl+"/teams", httpmock.NewStringResponder(http.StatusBadRequest, `{"id": "team0"}`))
+		httpmock.RegisterNoResponder(httpmock.NewStringResponder(http.StatusNotFound, ""))
+	}
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	shouldRetry := func(path string, params map[string]string) bool {
+		response, err := restClient.R().SetQueryParams(params).Get(path)
+		Expect(err).To(BeNil())
+
+		return readAfterCreate.ShouldRetry(response)
+	}
+
+	It("should retry a not found read of a created object", func() {
+		setup(time.Minute)
+
+		_, err := restClient.R().Post("/environments")
+		Expect(err).To(BeNil())
+		_, err = restClient.R().Post("/teams")
+		Expect(err).To(BeNil())
+
+		Expect(shouldRetry("/environments/env0", nil)).To(BeTrue())
+		Expect(shouldRetry("/environments/env0/deployments", nil)).To(BeTrue())
+		Expect(shouldRetry("/configuration", map[string]string{"environmentId": "env0"})).To(BeTrue())
+
+		Expect(shouldRetry("/environments/env1", nil)).To(BeFalse())
+		Expect(shouldRetry("/environments/env01", nil)).To(BeFalse())
+		Expect(shouldRetry("/teams/team0", nil)).To(BeFalse(), "the team wasn't created")
+	})
+
+	It("should not retry after the window", func() {
+		setup(200 * time.Millisecond)
+
+		_, err := restClient.R().Post("/environments")
+		Expect(err).To(BeNil())
+		Expect(shouldRetry("/environments/env0", nil)).To(BeTrue())
+
+		time.Sleep(300 * time.Millisecond)
+
+		Expect(shouldRetry("/environments/env0", nil)).To(BeFalse())
+	})
+
+	It("should not retry when disabled", func() {
+		setup(0)
+
+		_, err := restClient.R().Post("/environments")
+		Expect(err).To(BeNil())
+		Expect(shouldRetry("/environments/env0", nil)).To(BeFalse())
+	})
+})
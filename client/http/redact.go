@@ -0,0 +1,183 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Redacted replaces secrets in logged requests and responses.
+const Redacted = "[REDACTED]"
+
+// Headers that carry credentials.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// Body fields that are always secret, regardless of the endpoint (compared case insensitively).
+var sensitiveFields = []string{"apiKeySecret", "apiSecret", "secret", "password", "privateKey", "clientSecret", "secretAccessKey", "kubeConfig", "webhookSecret"}
+
+// Body fields that are secret when sent to (or received from) an endpoint with the given path segment.
+// Every field nested in them is redacted (e.g. all fields of a credentials payload's value).
+var sensitiveFieldsByPathSegment = map[string][]string{
+	"credentials": {"value"},
+	"ssh-keys":    {"value"},
+	"tokens":      {"value"},
+	// Notification endpoints (/notifications/endpoints): the value is the webhook url, which often carries a token.
+	"endpoints": {"value"},
+}
+
+// RequestLogFields returns the headers and the body of a request, with secrets redacted.
+func RequestLogFields(r *resty.Request) map[string]any {
+	header := r.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	if r.UserInfo != nil {
+		// Basic auth is added to the headers only when the request is sent (it's redacted anyway).
+		header.Set("Authorization", "Basic")
+	}
+
+	return map[string]any{
+		"method":  r.Method,
+		"url":     r.URL,
+		"headers": RedactHeaders(header),
+		"body":    RedactBody(r.URL, r.Body),
+	}
+}
+
+// ResponseLogFields returns the headers and the body of a response, with secrets redacted.
+func ResponseLogFields(r *resty.Response) map[string]any {
+	return map[string]any{
+		"method":  r.Request.Method,
+		"url":     r.Request.URL,
+		"status":  r.Status(),
+		"headers": RedactHeaders(r.Header()),
+		"body":    RedactBody(r.Request.URL, r.Body()),
+	}
+}
+
+// RedactHeaders returns the headers as a flat map, with credentials redacted.
+func RedactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+
+	for name, values := range header {
+		if containsFold(sensitiveHeaders, name) {
+			result[name] = Redacted
+		} else {
+			result[name] = strings.Join(values, ", ")
+		}
+	}
+
+	return result
+}
+
+// RedactBody returns a JSON body sent to (or received from) path, with secrets redacted:
+// fields that are always secret, secret fields of the endpoint (e.g. credentials values), and
+// the value of any configuration variable marked as sensitive.
+// Bodies that aren't JSON are not logged at all, as there's no way to tell what's in them.
+func RedactBody(path string, body any) string {
+	var raw []byte
+
+	switch b := body.(type) {
+	case nil:
+		return ""
+	case []byte:
+		raw = b
+	case string:
+		raw = []byte(b)
+	default:
+		var err error
+		if raw, err = json.Marshal(b); err != nil {
+			return fmt.Sprintf("[unserializable body: %T]", body)
+		}
+	}
+
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var parsed any
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return fmt.Sprintf("[non-JSON body: %d bytes]", len(raw))
+	}
+
+	redacted, err := json.Marshal(redactValue(parsed, pathSensitiveFields(path)))
+	if err != nil {
+		return fmt.Sprintf("[unserializable body: %T]", body)
+	}
+
+	return string(redacted)
+}
+
+func pathSensitiveFields(path string) []string {
+	if u, err := url.Parse(path); err == nil {
+		path = u.Path
+	}
+
+	var fields []string
+
+	for segment := range strings.SplitSeq(path, "/") {
+		fields = append(fields, sensitiveFieldsByPathSegment[segment]...)
+	}
+
+	return fields
+}
+
+func redactValue(value any, pathFields []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		isSensitiveVariable, _ := v["isSensitive"].(bool)
+
+		for key, nested := range v {
+			switch {
+			case isSensitiveVariable && key == "value",
+				containsFold(sensitiveFields, key),
+				containsFold(pathFields, key):
+				v[key] = redactAll(nested)
+			default:
+				v[key] = redactValue(nested, pathFields)
+			}
+		}
+
+		return v
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValue(nested, pathFields)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// redactAll redacts every value, keeping the structure (so it's still visible which fields were sent).
+func redactAll(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			v[key] = redactAll(nested)
+		}
+
+		return v
+	case []any:
+		for i, nested := range v {
+			v[i] = redactAll(nested)
+		}
+
+		return v
+	case nil:
+		return nil
+	default:
+		return Redacted
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, value) })
+}
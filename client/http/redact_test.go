@@ -0,0 +1,82 @@
+package http_test
+
+import (
+	"net/http"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Redact", func() {
+	Describe("RedactHeaders", func() {
+		It("should redact credentials", func() {
+			headers := httpModule.RedactHeaders(http.Header{
+				"Authorization": []string{"Basic bXlfdXNlcjpteV9wYXNz"},
+				"Cookie":        []string{"session=secret"},
+				"Content-Type":  []string{"application/json"},
+			})
+
+			Expect(headers).To(Equal(map[string]string{
+				"Authorization": httpModule.Redacted,
+				"Cookie":        httpModule.Redacted,
+				"Content-Type":  "application/json",
+			}))
+		})
+	})
+
+	Describe("RedactBody", func() {
+		DescribeTable("bodies",
+			func(path string, body any, expected string) {
+				Expect(httpModule.RedactBody(path, body)).To(Equal(expected))
+			},
+			Entry("no body", "/projects", nil, ""),
+			Entry("empty body", "/projects", []byte{}, ""),
+			Entry("non JSON body", "/projects", "secret", "[non-JSON body: 6 bytes]"),
+			Entry("plain body", "/projects", map[string]any{"name": "project"}, `{"name":"project"}`),
+			Entry("credentials value",
+				"/credentials/id0",
+				map[string]any{"name": "aws", "value": map[string]any{"accessKeyId": "id", "secretAccessKey": "secret", "duration": 3600}},
+				`{"name":"aws","value":{"accessKeyId":"[REDACTED]","duration":"[REDACTED]","secretAccessKey":"[REDACTED]"}}`,
+			),
+			Entry("credentials value with a full url",
+				"https://api.env0.com/credentials?organizationId=org0",
+				`[{"name":"aws","value":{"roleArn":"arn"}}]`,
+				`[{"name":"aws","value":{"roleArn":"[REDACTED]"}}]`,
+			),
+			Entry("ssh key value", "/ssh-keys", map[string]any{"name": "key", "value": "private"}, `{"name":"key","value":"[REDACTED]"}`),
+			Entry("notification endpoint value",
+				"/notifications/endpoints/id0",
+				map[string]any{"name": "slack", "type": "Slack", "value": "https://hooks.slack.com/services/token"},
+				`{"name":"slack","type":"Slack","value":"[REDACTED]"}`,
+			),
+			Entry("webhook secret", "/notifications/endpoints", []byte(`{"name":"hook","webhookSecret":"secret"}`), `{"name":"hook","webhookSecret":"[REDACTED]"}`),
+			Entry("value of other endpoints", "/environments", map[string]any{"value": "plain"}, `{"value":"plain"}`),
+			Entry("always secret fields", "/api-keys", []byte(`{"id":"id0","apiKeySecret":"secret"}`), `{"apiKeySecret":"[REDACTED]","id":"id0"}`),
+			Entry("sensitive configuration variables",
+				"/environments",
+				map[string]any{"configurationChanges": []any{
+					map[string]any{"name": "a", "value": "secret", "isSensitive": true},
+					map[string]any{"name": "b", "value": "plain", "isSensitive": false},
+				}},
+				`{"configurationChanges":[{"isSensitive":true,"name":"a","value":"[REDACTED]"},{"isSensitive":false,"name":"b","value":"plain"}]}`,
+			),
+		)
+	})
+
+	Describe("RequestLogFields", func() {
+		It("should redact basic auth", func() {
+			request := resty.New().R().SetBasicAuth("key", "secret")
+			request.Method = "POST"
+			request.URL = "/credentials"
+			request.Body = map[string]any{"value": map[string]any{"clientSecret": "secret"}}
+
+			fields := httpModule.RequestLogFields(request)
+
+			Expect(fields["headers"]).To(HaveKeyWithValue("Authorization", httpModule.Redacted))
+			Expect(fields["body"]).To(Equal(`{"value":{"clientSecret":"[REDACTED]"}}`))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Headers that may carry how long to wait before sending the next request, in order of precedence.
+const (
+	retryAfterHeader      = "Retry-After"
+	rateLimitResetHeader  = "RateLimit-Reset"
+	xRateLimitResetHeader = "X-RateLimit-Reset"
+)
+
+const (
+	// Reset header values above this are treated as unix timestamps rather than delay-seconds.
+	unixTimestampLowerBound = 1_000_000_000
+	// Guards against bogus header values blocking the provider forever.
+	maxRetryAfter = 24 * time.Hour
+)
+
+// RetryAfter implements resty.RetryAfterFunc.
+// It honors the wait time requested by the server (see ParseRetryAfter).
+// When the server doesn't request a specific wait time, (0, nil) is returned and resty falls back to
+// a capped exponential backoff with jitter between the client's retry wait time and max wait time.
+func RetryAfter(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	if response == nil {
+		return 0, nil
+	}
+
+	return ParseRetryAfter(response.Header(), time.Now()), nil
+}
+
+// ParseRetryAfter returns how long the server asked the client to wait before retrying.
+// It supports "Retry-After" (delay-seconds or an HTTP-date), "RateLimit-Reset" (delay-seconds)
+// and "X-RateLimit-Reset" (delay-seconds or a unix timestamp).
+// Returns 0 if none of the headers are present or parsable.
+func ParseRetryAfter(header http.Header, now time.Time) time.Duration {
+	if header == nil {
+		return 0
+	}
+
+	if value := strings.TrimSpace(header.Get(retryAfterHeader)); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return clampRetryAfter(time.Duration(seconds) * time.Second)
+		}
+
+		if date, err := http.ParseTime(value); err == nil {
+			return clampRetryAfter(date.Sub(now))
+		}
+	}
+
+	for _, name := range []string{rateLimitResetHeader, xRateLimitResetHeader} {
+		value := strings.TrimSpace(header.Get(name))
+		if value == "" {
+			continue
+		}
+
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if seconds > unixTimestampLowerBound {
+			return clampRetryAfter(time.Unix(seconds, 0).Sub(now))
+		}
+
+		return clampRetryAfter(time.Duration(seconds) * time.Second)
+	}
+
+	return 0
+}
+
+func clampRetryAfter(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+
+	return min(d, maxRetryAfter)
+}
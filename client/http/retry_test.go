@@ -0,0 +1,37 @@
+package http_test
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseRetryAfter", func() {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("headers",
+		func(headers map[string]string, expected time.Duration) {
+			header := http.Header{}
+			for key, value := range headers {
+				header.Set(key, value)
+			}
+
+			Expect(httpModule.ParseRetryAfter(header, now)).To(Equal(expected))
+		},
+		Entry("no headers", nil, time.Duration(0)),
+		Entry("Retry-After seconds", map[string]string{"Retry-After": "7"}, 7*time.Second),
+		Entry("Retry-After http date", map[string]string{"Retry-After": now.Add(90 * time.Second).Format(http.TimeFormat)}, 90*time.Second),
+		Entry("Retry-After date in the past", map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)}, time.Duration(0)),
+		Entry("Retry-After invalid", map[string]string{"Retry-After": "soon"}, time.Duration(0)),
+		Entry("Retry-After wins over reset headers", map[string]string{"Retry-After": "2", "RateLimit-Reset": "30"}, 2*time.Second),
+		Entry("RateLimit-Reset seconds", map[string]string{"RateLimit-Reset": "30"}, 30*time.Second),
+		Entry("X-RateLimit-Reset seconds", map[string]string{"X-RateLimit-Reset": "12"}, 12*time.Second),
+		Entry("X-RateLimit-Reset unix timestamp", map[string]string{"X-RateLimit-Reset": strconv.FormatInt(now.Add(45*time.Second).Unix(), 10)}, 45*time.Second),
+		Entry("huge value is capped", map[string]string{"Retry-After": "999999999"}, 24*time.Hour),
+	)
+})
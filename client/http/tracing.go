@@ -0,0 +1,117 @@
+package http
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/env0/terraform-provider-env0/client/http"
+
+// tracer returns the tracer of the global tracer provider (a no-op tracer unless tracing is enabled).
+func tracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer(tracerName)
+}
+
+// startRequestSpan starts the span of an HttpClient request: the rate limiter wait and all the attempts (retries) of the request.
+func startRequestSpan(ctx context.Context, method string, path string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, "env0 API "+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("http.request.method", method),
+		attribute.String("url.path", path),
+	))
+}
+
+// endRequestSpan ends the span of an HttpClient request with its result.
+func endRequestSpan(span trace.Span, response *resty.Response, err error) {
+	if response != nil && response.RawResponse != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode()))
+
+		if !response.IsSuccess() && err == nil {
+			err = newFailedResponseError(response)
+		}
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// waitForRateLimiter waits for the rate limiter (if any) in a span.
+func (client *HttpClient) waitForRateLimiter(ctx context.Context) error {
+	if *client.rateLimiter == nil {
+		return nil
+	}
+
+	ctx, span := tracer().Start(ctx, "env0 API rate limiter wait")
+	defer span.End()
+
+	if err := (*client.rateLimiter).Wait(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// attemptSpanKey is the context key of the span of the current attempt of a request.
+type attemptSpanKey struct{}
+
+type attemptSpan struct {
+	// parent is the context of the request, before any attempt.
+	parent context.Context
+	span   trace.Span
+}
+
+// traceAttempts adds a span per attempt (the first request, and every retry) of the requests of the rest client.
+func traceAttempts(restClient *resty.Client) {
+	restClient.
+		OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+			ctx := r.Context()
+
+			if previous, ok := ctx.Value(attemptSpanKey{}).(*attemptSpan); ok {
+				// The previous attempt failed without a response (no response hook was called).
+				previous.span.SetStatus(codes.Error, "no response")
+				previous.span.End()
+
+				ctx = previous.parent
+			}
+
+			attemptCtx, span := tracer().Start(ctx, "env0 API attempt", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.Int("http.request.resend_count", r.Attempt-1),
+			))
+
+			r.SetContext(context.WithValue(attemptCtx, attemptSpanKey{}, &attemptSpan{parent: ctx, span: span}))
+
+			return nil
+		}).
+		OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+			if attempt, ok := r.Request.Context().Value(attemptSpanKey{}).(*attemptSpan); ok {
+				attempt.span.SetAttributes(attribute.Int("http.response.status_code", r.StatusCode()))
+
+				if r.IsError() {
+					attempt.span.SetStatus(codes.Error, r.Status())
+				}
+
+				attempt.span.End()
+			}
+
+			return nil
+		}).
+		OnError(func(r *resty.Request, err error) {
+			if attempt, ok := r.Context().Value(attemptSpanKey{}).(*attemptSpan); ok {
+				attempt.span.RecordError(err)
+				attempt.span.SetStatus(codes.Error, err.Error())
+				attempt.span.End()
+			}
+		})
+}
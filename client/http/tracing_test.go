@@ -0,0 +1,123 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/env0/terraform-provider-env0/client/http/ratelimiter"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("Tracing", func() {
+	const BaseUrl = "https://fake.env0.com"
+
+	var (
+		httpclient             *httpModule.HttpClient
+		exporter               *tracetest.InMemoryExporter
+		previousTracerProvider trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		previousTracerProvider = otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+		restClient := resty.New().
+			SetRetryCount(1).
+			SetRetryWaitTime(time.Millisecond).
+			AddRetryCondition(func(r *resty.Response, err error) bool {
+				return r.StatusCode() >= 500
+			})
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		var err error
+
+		httpclient, err = httpModule.NewHttpClient(httpModule.HttpClientConfig{
+			ApiKey:      "key",
+			ApiSecret:   "secret",
+			ApiEndpoint: BaseUrl,
+			RestClient:  restClient,
+			RateLimiter: ratelimiter.NewSlidingWindowLimiter(100, time.Minute),
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+		otel.SetTracerProvider(previousTracerProvider)
+	})
+
+	spansByName := func(name string) tracetest.SpanStubs {
+		var spans tracetest.SpanStubs
+
+		for _, span := range exporter.GetSpans() {
+			if span.Name == name {
+				spans = append(spans, span)
+			}
+		}
+
+		return spans
+	}
+
+	It("should trace a request, its rate limiter wait and its attempts", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+"/projects/project0", httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(503, ""),
+			httpmock.NewStringResponse(200, `{"id": 1}`),
+		}))
+
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "env0_project read")
+
+		var result ResponseType
+
+		Expect(httpclient.WithContext(ctx).Get("/projects/project0", nil, &result)).To(Succeed())
+		parent.End()
+
+		requests := spansByName("env0 API GET")
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Parent.SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(requests[0].Attributes).To(ContainElements(
+			attribute.String("url.path", "/projects/project0"),
+			attribute.Int("http.response.status_code", 200),
+		))
+		Expect(requests[0].Status.Code).To(Equal(codes.Unset))
+
+		waits := spansByName("env0 API rate limiter wait")
+		Expect(waits).To(HaveLen(1))
+		Expect(waits[0].Parent.SpanID()).To(Equal(requests[0].SpanContext.SpanID()))
+
+		attempts := spansByName("env0 API attempt")
+		Expect(attempts).To(HaveLen(2))
+
+		for i, attempt := range attempts {
+			Expect(attempt.Parent.SpanID()).To(Equal(requests[0].SpanContext.SpanID()))
+			Expect(attempt.Attributes).To(ContainElement(attribute.Int("http.request.resend_count", i)))
+		}
+
+		Expect(attempts[0].Attributes).To(ContainElement(attribute.Int("http.response.status_code", 503)))
+		Expect(attempts[0].Status.Code).To(Equal(codes.Error))
+		Expect(attempts[1].Attributes).To(ContainElement(attribute.Int("http.response.status_code", 200)))
+	})
+
+	It("should mark a failed request as an error", func() {
+		httpmock.RegisterResponder("POST", BaseUrl+"/projects", httpmock.NewStringResponder(400, `{"message": "invalid name"}`))
+
+		Expect(httpclient.Post("/projects", RequestBody{Message: "project"}, nil)).NotTo(Succeed())
+
+		requests := spansByName("env0 API POST")
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Status.Code).To(Equal(codes.Error))
+		Expect(requests[0].Status.Description).To(ContainSubstring("invalid name"))
+		Expect(spansByName("env0 API attempt")).To(HaveLen(1))
+	})
+})
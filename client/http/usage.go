@@ -0,0 +1,167 @@
+package http
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Path segments that are ids (UUIDs or numbers). They are replaced by "{id}", so requests of the same endpoint are counted together.
+var idPathSegment = regexp.MustCompile(`^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|\d+)$`)
+
+// EndpointUsage is the usage of an env0 API endpoint (E.g. GET /projects/{id}).
+type EndpointUsage struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Requests is the number of requests sent (a request and its retries are counted once).
+	Requests int64 `json:"requests"`
+	// Retries is the number of retries (attempts after the first attempt of a request).
+	Retries int64 `json:"retries"`
+	// RateLimited is the number of 429 (Too Many Requests) responses.
+	RateLimited int64 `json:"rate_limited"`
+	// Errors is the number of requests that failed (after all the retries).
+	Errors int64 `json:"errors"`
+	// Latency is the cumulative time of the requests (including retries), without the rate limiter waits.
+	Latency time.Duration `json:"-"`
+	// RateLimiterWait is the cumulative time the requests waited for the (client side) rate limiter.
+	RateLimiterWait time.Duration `json:"-"`
+
+	LatencyMs         int64 `json:"latency_ms"`
+	RateLimiterWaitMs int64 `json:"rate_limiter_wait_ms"`
+}
+
+func (u *EndpointUsage) add(other EndpointUsage) {
+	u.Requests += other.Requests
+	u.Retries += other.Retries
+	u.RateLimited += other.RateLimited
+	u.Errors += other.Errors
+	u.Latency += other.Latency
+	u.RateLimiterWait += other.RateLimiterWait
+	u.LatencyMs = u.Latency.Milliseconds()
+	u.RateLimiterWaitMs = u.RateLimiterWait.Milliseconds()
+}
+
+// UsageSummary is the usage of the env0 API by a client.
+type UsageSummary struct {
+	Total EndpointUsage `json:"total"`
+	// Endpoints are sorted by the number of requests (the most used endpoint first).
+	Endpoints []EndpointUsage `json:"endpoints"`
+}
+
+func newUsageSummary(endpoints map[string]*EndpointUsage) UsageSummary {
+	summary := UsageSummary{Endpoints: []EndpointUsage{}}
+
+	for _, usage := range endpoints {
+		endpoint := EndpointUsage{Method: usage.Method, Path: usage.Path}
+		endpoint.add(*usage)
+
+		summary.Endpoints = append(summary.Endpoints, endpoint)
+		summary.Total.add(endpoint)
+	}
+
+	slices.SortFunc(summary.Endpoints, func(a, b EndpointUsage) int {
+		return cmp.Or(cmp.Compare(b.Requests, a.Requests), cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+	})
+
+	return summary
+}
+
+// usageStats collects the usage of the env0 API, by endpoint. It's shared by an HttpClient and all its copies.
+type usageStats struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointUsage
+}
+
+// usageKey is the context key of the endpoint of a request.
+type usageKey struct{}
+
+type endpoint struct {
+	method string
+	path   string
+}
+
+func newUsageStats() *usageStats {
+	return &usageStats{endpoints: map[string]*EndpointUsage{}}
+}
+
+// pathTemplate returns the path with ids replaced by "{id}" (E.g. /projects/{id}/environments).
+func pathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		if idPathSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// withEndpoint returns a context of a request of the endpoint, so the usage of the request is counted (see countAttempts).
+func withEndpoint(ctx context.Context, method string, path string) context.Context {
+	return context.WithValue(ctx, usageKey{}, endpoint{method: method, path: pathTemplate(path)})
+}
+
+func (u *usageStats) update(ctx context.Context, f func(*EndpointUsage)) {
+	e, ok := ctx.Value(usageKey{}).(endpoint)
+	if !ok {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	key := e.method + " " + e.path
+	if u.endpoints[key] == nil {
+		u.endpoints[key] = &EndpointUsage{Method: e.method, Path: e.path}
+	}
+
+	f(u.endpoints[key])
+}
+
+// countAttempts counts the retries and the 429 responses of the requests of the rest client.
+func (u *usageStats) countAttempts(restClient *resty.Client) {
+	restClient.
+		OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+			if r.Attempt > 1 {
+				u.update(r.Context(), func(usage *EndpointUsage) { usage.Retries++ })
+			}
+
+			return nil
+		}).
+		OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+			if r.StatusCode() == http.StatusTooManyRequests {
+				u.update(r.Request.Context(), func(usage *EndpointUsage) { usage.RateLimited++ })
+			}
+
+			return nil
+		})
+}
+
+func (u *usageStats) summary() UsageSummary {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return newUsageSummary(u.endpoints)
+}
+
+// countRequest counts a request of the endpoint of ctx, that was sent at start (after the rate limiter wait).
+func (client *HttpClient) countRequest(ctx context.Context, start time.Time, response *resty.Response, err error) {
+	failed := client.httpResult(response, err) != nil
+
+	client.usage.update(ctx, func(usage *EndpointUsage) {
+		usage.Requests++
+		usage.Latency += time.Since(start)
+
+		if failed {
+			usage.Errors++
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	httpModule "github.com/env0/terraform-provider-env0/client/http"
+	"github.com/env0/terraform-provider-env0/client/http/ratelimiter"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage", func() {
+	const BaseUrl = "https://fake.env0.com"
+
+	var httpclient *httpModule.HttpClient
+
+	BeforeEach(func() {
+		restClient := resty.New().
+			SetRetryCount(2).
+			SetRetryWaitTime(time.Millisecond).
+			AddRetryCondition(func(r *resty.Response, err error) bool {
+				return r.StatusCode() == http.StatusTooManyRequests || r.StatusCode() >= 500
+			})
+		httpmock.ActivateNonDefault(restClient.GetClient())
+
+		var err error
+
+		httpclient, err = httpModule.NewHttpClient(httpModule.HttpClientConfig{
+			ApiKey:      "key",
+			ApiSecret:   "secret",
+			ApiEndpoint: BaseUrl,
+			RestClient:  restClient,
+			RateLimiter: ratelimiter.NewSlidingWindowLimiter(100, 10*time.Millisecond),
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	endpointUsage := func(summary httpModule.UsageSummary, method string, path string) httpModule.EndpointUsage {
+		for _, usage := range summary.Endpoints {
+			if usage.Method == method && usage.Path == path {
+				return usage
+			}
+		}
+
+		Fail("no usage of " + method + " " + path)
+
+		return httpModule.EndpointUsage{}
+	}
+
+	It("should count the requests, retries, 429 responses and errors per endpoint", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+"/projects/5b8c2d4e-1a2b-4c3d-8e9f-0a1b2c3d4e5f", httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusTooManyRequests, ""),
+			httpmock.NewStringResponse(http.StatusOK, `{"id": 1}`),
+		}))
+		httpmock.RegisterResponder("GET", BaseUrl+"/projects/6c9d3e5f-2b3c-4d4e-9f0a-1b2c3d4e5f60", httpmock.NewStringResponder(http.StatusOK, `{"id": 2}`))
+		httpmock.RegisterResponder("POST", BaseUrl+"/projects", httpmock.NewStringResponder(http.StatusBadRequest, `{"message": "invalid name"}`))
+		httpmock.RegisterResponder("DELETE", BaseUrl+"/teams/123", httpmock.NewStringResponder(http.StatusInternalServerError, ""))
+
+		var result ResponseType
+
+		Expect(httpclient.Get("/projects/5b8c2d4e-1a2b-4c3d-8e9f-0a1b2c3d4e5f", nil, &result)).To(Succeed())
+		Expect(httpclient.Get("/projects/6c9d3e5f-2b3c-4d4e-9f0a-1b2c3d4e5f60", nil, &result)).To(Succeed())
+		Expect(httpclient.Post("/projects", RequestBody{Message: "project"}, nil)).NotTo(Succeed())
+		Expect(httpclient.Delete("/teams/123", nil)).NotTo(Succeed())
+
+		summary := httpclient.Usage()
+
+		Expect(summary.Endpoints).To(HaveLen(3))
+		Expect(summary.Endpoints[0].Method + " " + summary.Endpoints[0].Path).To(Equal("GET /projects/{id}"))
+
+		get := endpointUsage(summary, "GET", "/projects/{id}")
+		Expect(get.Requests).To(Equal(int64(2)))
+		Expect(get.Retries).To(Equal(int64(1)))
+		Expect(get.RateLimited).To(Equal(int64(1)))
+		Expect(get.Errors).To(BeZero())
+		Expect(get.Latency).To(BeNumerically(">", 0))
+		Expect(get.RateLimiterWait).To(BeNumerically(">", 0))
+
+		post := endpointUsage(summary, "POST", "/projects")
+		Expect(post.Requests).To(Equal(int64(1)))
+		Expect(post.Retries).To(BeZero())
+		Expect(post.Errors).To(Equal(int64(1)))
+
+		del := endpointUsage(summary, "DELETE", "/teams/{id}")
+		Expect(del.Requests).To(Equal(int64(1)))
+		Expect(del.Retries).To(Equal(int64(2)))
+		Expect(del.Errors).To(Equal(int64(1)))
+
+		Expect(summary.Total.Requests).To(Equal(int64(4)))
+		Expect(summary.Total.Retries).To(Equal(int64(3)))
+		Expect(summary.Total.RateLimited).To(Equal(int64(1)))
+		Expect(summary.Total.Errors).To(Equal(int64(2)))
+		Expect(summary.Total.Latency).To(Equal(get.Latency + post.Latency + del.Latency))
+	})
+
+	It("should count the usage of copies of the client", func() {
+		httpmock.RegisterResponder("GET", BaseUrl+"/organizations", httpmock.NewStringResponder(http.StatusOK, `[]`))
+
+		var result []ResponseType
+
+		Expect(httpclient.WithContext(context.Background()).Get("/organizations", nil, &result)).To(Succeed())
+
+		Expect(httpclient.Usage().Total.Requests).To(Equal(int64(1)))
+	})
+})
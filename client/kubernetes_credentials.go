@@ -61,8 +61,17 @@ func (client *ApiClient) KubernetesCredentialsCreate(payload *KubernetesCredenti
 		Value:          payload.Value,
 	}
 
-	var result Credentials
-	if err := client.http.Post("/credentials", payloadWithOrganizatioId, &result); err != nil {
+	result, err := createOrAdopt(client, "credentials", payload.Name, func() (Credentials, error) {
+		var result Credentials
+		if err := client.http.Post("/credentials", payloadWithOrganizatioId, &result); err != nil {
+			return Credentials{}, err
+		}
+
+		return result, nil
+	}, func() ([]Credentials, error) {
+		return client.lookupCredentials(payload.Name, "")
+	}, func(object Credentials) string { return object.CreatedAt })
+	if err != nil {
 		return nil, err
 	}
 
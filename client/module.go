@@ -1,6 +1,9 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
 type ModuleSshKey struct {
 	Id   string `json:"id"`
@@ -152,15 +155,10 @@ func (client *ApiClient) ModuleUpdate(id string, payload ModuleUpdatePayload) (*
 }
 
 func (client *ApiClient) Modules() ([]Module, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []Module
-	if err := client.http.Get("/modules", map[string]string{"organizationId": organizationId}, &result); err != nil {
-		return nil, err
-	}
+	return collect(client.ModulesIter())
+}
 
-	return result, err
+// ModulesIter returns an iterator over all the modules. Pages are fetched lazily.
+func (client *ApiClient) ModulesIter() iter.Seq2[Module, error] {
+	return paginate(organizationPages[Module](client, organizationEndpoint("/modules"), offsetPages[Module]))
 }
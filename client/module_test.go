@@ -47,7 +47,7 @@ var _ = Describe("Module Client", func() {
 			mockOrganizationIdCall()
 
 			httpCall = mockHttpClient.EXPECT().
-				Get("/modules", map[string]string{"organizationId": organizationId}, gomock.Any()).
+				Get("/modules", map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}, gomock.Any()).
 				Do(func(path string, request any, response *[]Module) {
 					*response = mockModules
 				})
@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type NotificationType string
 
 const (
@@ -39,18 +41,12 @@ type NotificationUpdatePayload struct {
 }
 
 func (client *ApiClient) Notifications() ([]Notification, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []Notification
-
-	if err := client.http.Get("/notifications/endpoints", map[string]string{"organizationId": organizationId}, &result); err != nil {
-		return nil, err
-	}
+	return collect(client.NotificationsIter())
+}
 
-	return result, nil
+// NotificationsIter returns an iterator over all the notification endpoints. The endpoint isn't paginated, so they are fetched in a single request.
+func (client *ApiClient) NotificationsIter() iter.Seq2[Notification, error] {
+	return paginate(organizationPages[Notification](client, organizationEndpoint("/notifications/endpoints"), singlePage[Notification]))
 }
 
 func (client *ApiClient) NotificationCreate(payload NotificationCreatePayload) (*Notification, error) {
@@ -3,6 +3,7 @@ package client
 import (
 	"errors"
 	"fmt"
+	"sync"
 )
 
 type Organization struct {
@@ -42,6 +43,16 @@ func (client *ApiClient) Organization() (Organization, error) {
 		return Organization{}, err
 	}
 
+	if client.organizationIdOverride != "" {
+		for _, organization := range result {
+			if organization.Id == client.organizationIdOverride {
+				return organization, nil
+			}
+		}
+
+		return Organization{}, notAssignedToOrganizationError(client.organizationIdOverride)
+	}
+
 	if len(result) != 1 {
 		if client.defaultOrganizationId != "" {
 			for _, organization := range result {
@@ -50,7 +61,7 @@ func (client *ApiClient) Organization() (Organization, error) {
 				}
 			}
 
-			return Organization{}, fmt.Errorf("the api key is not assigned to organization id: %s", client.defaultOrganizationId)
+			return Organization{}, notAssignedToOrganizationError(client.defaultOrganizationId)
 		}
 
 		return Organization{}, errors.New("server responded with too many organizations (set a default organization id in the provider settings)")
@@ -59,9 +70,45 @@ func (client *ApiClient) Organization() (Organization, error) {
 	return result[0], nil
 }
 
+// cachedOrganizationId is shared by an ApiClient and all its copies.
+type cachedOrganizationId struct {
+	mu sync.Mutex
+	id string
+	// organizationIds are the ids of the organizations of the api key, fetched on the first use of an organization id override (see WithOrganizationId).
+	organizationIds map[string]bool
+}
+
+func notAssignedToOrganizationError(organizationId string) error {
+	return fmt.Errorf("the api key is not assigned to organization id: %s", organizationId)
+}
+
 func (client *ApiClient) OrganizationId() (string, error) {
-	if client.cachedOrganizationId != "" {
-		return client.cachedOrganizationId, nil
+	// The lock is held during the lookup, so concurrent callers wait for it instead of repeating it.
+	client.cachedOrganizationId.mu.Lock()
+	defer client.cachedOrganizationId.mu.Unlock()
+
+	if client.organizationIdOverride != "" {
+		if client.cachedOrganizationId.organizationIds == nil {
+			var organizations []Organization
+			if err := client.http.Get("/organizations", nil, &organizations); err != nil {
+				return "", err
+			}
+
+			client.cachedOrganizationId.organizationIds = map[string]bool{}
+			for _, organization := range organizations {
+				client.cachedOrganizationId.organizationIds[organization.Id] = true
+			}
+		}
+
+		if !client.cachedOrganizationId.organizationIds[client.organizationIdOverride] {
+			return "", notAssignedToOrganizationError(client.organizationIdOverride)
+		}
+
+		return client.organizationIdOverride, nil
+	}
+
+	if client.cachedOrganizationId.id != "" {
+		return client.cachedOrganizationId.id, nil
 	}
 
 	organization, err := client.Organization()
@@ -69,9 +116,9 @@ func (client *ApiClient) OrganizationId() (string, error) {
 		return "", err
 	}
 
-	client.cachedOrganizationId = organization.Id
+	client.cachedOrganizationId.id = organization.Id
 
-	return client.cachedOrganizationId, nil
+	return client.cachedOrganizationId.id, nil
 }
 
 func (client *ApiClient) OrganizationPolicyUpdate(payload OrganizationPolicyUpdatePayload) (*Organization, error) {
@@ -96,6 +96,84 @@ var _ = Describe("Organization", func() {
 		})
 	})
 
+	Describe("WithOrganizationId", func() {
+		const otherOrganizationId = "organization2"
+
+		mockOrganizationsCall := func() *gomock.Call {
+			return mockHttpClient.EXPECT().
+				Get("/organizations", nil, gomock.Any()).
+				Do(func(path string, request any, response *[]Organization) {
+					*response = []Organization{mockOrganization, {Id: otherOrganizationId, Name: "other"}}
+				})
+		}
+
+		It("Should validate the organization ids with a single request", func() {
+			mockOrganizationsCall().Times(1)
+
+			for range 2 {
+				for _, id := range []string{organizationId, otherOrganizationId} {
+					organizationId, err := apiClient.WithOrganizationId(id).OrganizationId()
+					Expect(err).To(BeNil())
+					Expect(organizationId).To(Equal(id))
+				}
+			}
+
+			_, err := apiClient.WithOrganizationId("unknown").OrganizationId()
+			Expect(err).To(MatchError("the api key is not assigned to organization id: unknown"))
+		})
+
+		It("Should return the organization", func() {
+			mockOrganizationsCall().Times(1)
+
+			organization, err := apiClient.WithOrganizationId(otherOrganizationId).Organization()
+			Expect(err).To(BeNil())
+			Expect(organization.Name).To(Equal("other"))
+		})
+
+		It("Should fail when the api key isn't assigned to the organization", func() {
+			mockOrganizationsCall().Times(1)
+
+			_, err := apiClient.WithOrganizationId("unknown").OrganizationId()
+			Expect(err).To(MatchError("the api key is not assigned to organization id: unknown"))
+		})
+
+		It("Should validate even if the api key has a single organization", func() {
+			mockOrganizationIdCall().Times(1)
+
+			_, err := apiClient.WithOrganizationId(otherOrganizationId).OrganizationId()
+			Expect(err).To(MatchError("the api key is not assigned to organization id: " + otherOrganizationId))
+		})
+
+		It("Should not share cached lists between organizations", func() {
+			mockOrganizationsCall().Times(1)
+
+			for _, id := range []string{organizationId, otherOrganizationId} {
+				mockHttpClient.EXPECT().
+					Get("/projects", map[string]string{"organizationId": id, "limit": "100", "offset": "0"}, gomock.Any()).
+					Times(1).
+					Do(func(path string, request any, response *[]Project) {
+						*response = []Project{{Id: id + "-project"}}
+					})
+			}
+
+			for range 2 {
+				for _, id := range []string{organizationId, otherOrganizationId} {
+					projects, err := apiClient.WithOrganizationId(id).Projects()
+					Expect(err).To(BeNil())
+					Expect(projects).To(Equal([]Project{{Id: id + "-project"}}))
+				}
+			}
+		})
+
+		It("Should use the provider's organization when empty", func() {
+			mockOrganizationIdCall().Times(1)
+
+			id, err := apiClient.WithOrganizationId("").OrganizationId()
+			Expect(err).To(BeNil())
+			Expect(id).To(Equal(organizationId))
+		})
+	})
+
 	Describe("OrganizationPolicyUpdate", func() {
 		hour12 := "12-h"
 		t := true
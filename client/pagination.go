@@ -1,62 +1,154 @@
 package client
 
 import (
+	"iter"
 	"maps"
 	"strconv"
 )
 
 const limit = 100
 
-type Pagination struct {
-	offset int
-	params map[string]string
+// pageFetcher fetches the page of the given token ("" for the first page).
+// Returns the items of the page, and the token of the next page ("" if it's the last page).
+type pageFetcher[T any] func(token string) ([]T, string, error)
+
+// paginate returns an iterator over the items of all the pages.
+// Pages are fetched lazily, so stopping the iteration early saves the requests of the remaining pages.
+// On failure the error is yielded (with a zero item) and the iteration stops.
+func paginate[T any](fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		token := ""
+
+		for {
+			items, nextToken, err := fetch(token)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if nextToken == "" {
+				return
+			}
+
+			token = nextToken
+		}
+	}
 }
 
-type Paginated interface {
-	Environment
-	getEndpoint() string
+// collect returns all the items of an iterator returned by paginate.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var result []T
+
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
 }
 
-func (p *Pagination) getParams() map[string]string {
-	return map[string]string{
-		"limit":  strconv.Itoa(limit),
-		"offset": strconv.Itoa(p.offset),
+// offsetPages fetches pages of an endpoint that paginates with the "limit" and "offset" query params.
+// params - additional params. may be nil.
+func offsetPages[T any](client *ApiClient, endpoint string, params map[string]string) pageFetcher[T] {
+	return func(token string) ([]T, string, error) {
+		offset := 0
+		if token != "" {
+			offset, _ = strconv.Atoi(token)
+		}
+
+		pageParams := map[string]string{
+			"limit":  strconv.Itoa(limit),
+			"offset": strconv.Itoa(offset),
+		}
+		maps.Copy(pageParams, params)
+
+		var page []T
+		if err := client.http.Get(endpoint, pageParams, &page); err != nil {
+			return nil, "", err
+		}
+
+		// A full page means there may be more data.
+		if len(page) != limit {
+			return page, "", nil
+		}
+
+		return page, strconv.Itoa(offset + len(page)), nil
 	}
 }
 
-// returns true if there is more data.
-func (p *Pagination) next(currentPageSize int) bool {
-	p.offset += currentPageSize
+// singlePage fetches an endpoint that isn't paginated (returns all the items in a single response).
+// params - the query params. may be nil.
+func singlePage[T any](client *ApiClient, endpoint string, params map[string]string) pageFetcher[T] {
+	return func(token string) ([]T, string, error) {
+		var page []T
+		if err := client.http.Get(endpoint, params, &page); err != nil {
+			return nil, "", err
+		}
 
-	return currentPageSize == limit
+		return page, "", nil
+	}
 }
 
-// params - additional params. may be nil.
-func getAll[P Paginated](client *ApiClient, params map[string]string) ([]P, error) {
-	p := Pagination{
-		offset: 0,
-		params: params,
+// organizationPages fetches the pages of an endpoint that lists the resources of the organization.
+// endpoint - returns the endpoint and its params (may be nil) for the organization.
+// pages - offsetPages for endpoints that paginate, or singlePage for endpoints that don't.
+func organizationPages[T any](client *ApiClient, endpoint func(organizationId string) (string, map[string]string), pages func(*ApiClient, string, map[string]string) pageFetcher[T]) pageFetcher[T] {
+	var fetch pageFetcher[T]
+
+	return func(token string) ([]T, string, error) {
+		if fetch == nil {
+			organizationId, err := client.OrganizationId()
+			if err != nil {
+				return nil, "", err
+			}
+
+			path, params := endpoint(organizationId)
+			fetch = pages(client, path, params)
+		}
+
+		return fetch(token)
 	}
+}
 
-	var allResults []P
+// organizationEndpoint returns an endpoint that lists resources of the organization by the "organizationId" param.
+func organizationEndpoint(endpoint string) func(organizationId string) (string, map[string]string) {
+	return func(organizationId string) (string, map[string]string) {
+		return endpoint, map[string]string{"organizationId": organizationId}
+	}
+}
 
-	for {
-		pageParams := p.getParams()
-		maps.Copy(pageParams, params)
+// getAll returns the items of all the pages of an endpoint that paginates with the "limit" and "offset" query params.
+// params - additional params. may be nil.
+func getAll[T any](client *ApiClient, endpoint string, params map[string]string) ([]T, error) {
+	return collect(paginate(offsetPages[T](client, endpoint, params)))
+}
 
-		var pageResults []P
+// FindFirst returns the first item of an iterator (E.g. ProjectsIter()) that matches.
+// Stops fetching pages once a match is found. Returns false if there's no match.
+func FindFirst[T any](seq iter.Seq2[T, error], match func(T) bool) (T, bool, error) {
+	var zero T
 
-		err := client.http.Get(P{}.getEndpoint(), pageParams, &pageResults)
+	for item, err := range seq {
 		if err != nil {
-			return nil, err
+			return zero, false, err
 		}
 
-		allResults = append(allResults, pageResults...)
-
-		if more := p.next(len(pageResults)); !more {
-			break
+		if match(item) {
+			return item, true, nil
 		}
 	}
 
-	return allResults, nil
+	return zero, false, nil
 }
@@ -0,0 +1,171 @@
+package client_test
+
+import (
+	"errors"
+	"strconv"
+
+	. "github.com/env0/terraform-provider-env0/client"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Pagination", func() {
+	projectsPage := func(from int, count int) []Project {
+		page := make([]Project, count)
+		for i := range page {
+			page[i] = Project{Id: "id" + strconv.Itoa(from+i)}
+		}
+
+		return page
+	}
+
+	expectProjectsPage := func(offset int, page []Project) *gomock.Call {
+		return mockHttpClient.EXPECT().
+			Get("/projects", map[string]string{"organizationId": organizationId, "limit": "100", "offset": strconv.Itoa(offset)}, gomock.Any()).
+			Do(func(path string, request any, response *[]Project) {
+				*response = page
+			})
+	}
+
+	Describe("Offset pagination", func() {
+		It("Should return the items of all the pages", func() {
+			mockOrganizationIdCall().Times(1)
+
+			gomock.InOrder(
+				expectProjectsPage(0, projectsPage(0, 100)).Times(1),
+				expectProjectsPage(100, projectsPage(100, 100)).Times(1),
+				expectProjectsPage(200, projectsPage(200, 3)).Times(1),
+			)
+
+			projects, err := apiClient.Projects()
+			Expect(err).To(BeNil())
+			Expect(projects).To(HaveLen(203))
+			Expect(projects[0].Id).To(Equal("id0"))
+			Expect(projects[202].Id).To(Equal("id202"))
+		})
+
+		It("Should stop when the last page is full but the next one is empty", func() {
+			mockOrganizationIdCall().Times(1)
+
+			gomock.InOrder(
+				expectProjectsPage(0, projectsPage(0, 100)).Times(1),
+				expectProjectsPage(100, nil).Times(1),
+			)
+
+			projects, err := apiClient.Projects()
+			Expect(err).To(BeNil())
+			Expect(projects).To(HaveLen(100))
+		})
+
+		It("Should return an error when a page fails", func() {
+			mockOrganizationIdCall().Times(1)
+
+			expectedErr := errors.New("error")
+
+			gomock.InOrder(
+				expectProjectsPage(0, projectsPage(0, 100)).Times(1),
+				expectProjectsPage(100, nil).Times(1).Return(expectedErr),
+			)
+
+			projects, err := apiClient.Projects()
+			Expect(err).To(Equal(expectedErr))
+			Expect(projects).To(BeNil())
+		})
+	})
+
+	Describe("Iterator", func() {
+		It("Should not fetch the next pages when stopped early", func() {
+			mockOrganizationIdCall().Times(1)
+			expectProjectsPage(0, projectsPage(0, 100)).Times(1)
+
+			var found Project
+
+			for project, err := range apiClient.ProjectsIter() {
+				Expect(err).To(BeNil())
+
+				if project.Id == "id42" {
+					found = project
+
+					break
+				}
+			}
+
+			Expect(found.Id).To(Equal("id42"))
+		})
+
+		It("Should find the first match without fetching the next pages", func() {
+			mockOrganizationIdCall().Times(1)
+			expectProjectsPage(0, projectsPage(0, 100)).Times(1)
+
+			project, found, err := FindFirst(apiClient.ProjectsIter(), func(project Project) bool { return project.Id == "id42" })
+			Expect(err).To(BeNil())
+			Expect(found).To(BeTrue())
+			Expect(project.Id).To(Equal("id42"))
+		})
+
+		It("Should fetch the roles in a single request without pagination params", func() {
+			mockOrganizationIdCall().Times(1)
+
+			mockHttpClient.EXPECT().
+				Get("/roles", map[string]string{"organizationId": organizationId}, gomock.Any()).
+				Times(1).
+				Do(func(path string, request any, response *[]Role) {
+					roles := make([]Role, 150)
+					for i := range roles {
+						roles[i] = Role{Id: "role" + strconv.Itoa(i)}
+					}
+
+					*response = roles
+				})
+
+			role, found, err := FindFirst(apiClient.RolesIter(), func(role Role) bool { return role.Id == "role120" })
+			Expect(err).To(BeNil())
+			Expect(found).To(BeTrue())
+			Expect(role.Id).To(Equal("role120"))
+		})
+
+		It("Should yield the error of the organization id lookup", func() {
+			expectedErr := errors.New("error")
+
+			mockHttpClient.EXPECT().Get("/organizations", nil, gomock.Any()).Times(1).Return(expectedErr)
+
+			var errs []error
+
+			for _, err := range apiClient.ProjectsIter() {
+				errs = append(errs, err)
+			}
+
+			Expect(errs).To(Equal([]error{expectedErr}))
+		})
+
+		It("Should iterate pages of endpoints that return a next page key", func() {
+			mockOrganizationIdCall().Times(1)
+
+			gomock.InOrder(
+				mockHttpClient.EXPECT().
+					Get("/teams/organizations/"+organizationId, map[string]string{"limit": "100"}, gomock.Any()).
+					Times(1).
+					Do(func(path string, request any, response *PaginatedTeamsResponse) {
+						*response = PaginatedTeamsResponse{Teams: []Team{{Id: "team1"}}, NextPageKey: "key"}
+					}),
+				mockHttpClient.EXPECT().
+					Get("/teams/organizations/"+organizationId, map[string]string{"limit": "100", "offset": "key"}, gomock.Any()).
+					Times(1).
+					Do(func(path string, request any, response *PaginatedTeamsResponse) {
+						*response = PaginatedTeamsResponse{Teams: []Team{{Id: "team2"}}}
+					}),
+			)
+
+			var ids []string
+
+			for team, err := range apiClient.TeamsIter() {
+				Expect(err).To(BeNil())
+
+				ids = append(ids, team.Id)
+			}
+
+			Expect(ids).To(Equal([]string{"team1", "team2"}))
+		})
+	})
+})
@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type Project struct {
 	IsArchived      bool     `json:"isArchived"`
 	OrganizationId  string   `json:"organizationId"`
@@ -35,18 +37,12 @@ type ModuleTestingProject struct {
 }
 
 func (client *ApiClient) Projects() ([]Project, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []Project
-
-	if err := client.http.Get("/projects", map[string]string{"organizationId": organizationId}, &result); err != nil {
-		return []Project{}, err
-	}
+	return cachedList(client.cache, client.cacheKey("projects"), func() ([]Project, error) { return collect(client.ProjectsIter()) })
+}
 
-	return result, nil
+// ProjectsIter returns an iterator over all the projects. Pages are fetched lazily, and results are not cached (unlike Projects()).
+func (client *ApiClient) ProjectsIter() iter.Seq2[Project, error] {
+	return paginate(organizationPages[Project](client, organizationEndpoint("/projects"), offsetPages[Project]))
 }
 
 func (client *ApiClient) Project(id string) (Project, error) {
@@ -60,6 +56,14 @@ func (client *ApiClient) Project(id string) (Project, error) {
 }
 
 func (client *ApiClient) ProjectCreate(payload ProjectCreatePayload) (Project, error) {
+	return createOrAdopt(client, "project", payload.Name, func() (Project, error) {
+		return client.projectCreate(payload)
+	}, func() ([]Project, error) {
+		return client.lookupProject(payload.Name, payload.ParentProjectId)
+	}, func(object Project) string { return object.CreatedAt })
+}
+
+func (client *ApiClient) projectCreate(payload ProjectCreatePayload) (Project, error) {
 	var result Project
 
 	organizationId, err := client.OrganizationId()
@@ -149,7 +149,7 @@ var _ = Describe("Project", func() {
 			mockOrganizationIdCall()
 
 			httpCall = mockHttpClient.EXPECT().
-				Get("/projects", map[string]string{"organizationId": organizationId}, gomock.Any()).
+				Get("/projects", map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}, gomock.Any()).
 				Do(func(path string, request any, response *[]Project) {
 					*response = mockProjects
 				})
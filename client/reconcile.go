@@ -0,0 +1,114 @@
+package client
+
+import (
+	"iter"
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// createdAtClockSkew is the clock skew allowed between the provider and the server when comparing creation times.
+const createdAtClockSkew = 30 * time.Second
+
+// createOrAdopt creates an object using create.
+// POST requests are not retried by default, because a create that failed ambiguously (a network error or a 5xx response)
+// may have been committed by the server, and retrying it may create a duplicate object that Terraform doesn't track.
+// Instead, after an ambiguous failure the objects with the same name and scope are listed using lookup.
+// Names aren't unique, so only an object that was created after the create request was sent is adopted (returned as if the create succeeded).
+// If no such object exists, more than one exists, or the lookup fails, the original error is returned.
+func createOrAdopt[T any](client *ApiClient, kind string, name string, create func() (T, error), lookup func() ([]T, error), createdAt func(T) string) (T, error) {
+	// Allow for a clock skew between the provider and the server.
+	sentAt := time.Now().Add(-createdAtClockSkew)
+
+	result, err := create()
+	if !http.IsAmbiguousFailure(err) {
+		return result, err
+	}
+
+	ctx := client.context()
+	fields := map[string]any{"kind": kind, "name": name, "error": err.Error()}
+
+	tflog.Warn(ctx, "Create request failed, checking whether the object was created anyway", fields)
+
+	objects, lookupErr := lookup()
+	if lookupErr != nil {
+		fields["lookup error"] = lookupErr.Error()
+		tflog.Warn(ctx, "Could not check whether the object was created", fields)
+
+		return result, err
+	}
+
+	var created []T
+
+	for _, object := range objects {
+		if objectCreatedAt, parseErr := time.Parse(time.RFC3339, createdAt(object)); parseErr == nil && !objectCreatedAt.Before(sentAt) {
+			created = append(created, object)
+		}
+	}
+
+	switch len(created) {
+	case 0:
+		tflog.Debug(ctx, "The object was not created", fields)
+
+		return result, err
+	case 1:
+		tflog.Warn(ctx, "The object was created despite the failed create request, adopting it", fields)
+
+		return created[0], nil
+	default:
+		fields["count"] = len(created)
+		tflog.Warn(ctx, "Multiple matching objects were created since the create request was sent, not adopting any of them", fields)
+
+		return result, err
+	}
+}
+
+// findAll returns the items of seq that match.
+func findAll[T any](seq iter.Seq2[T, error], match func(T) bool) ([]T, error) {
+	var result []T
+
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		if match(item) {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+func (client *ApiClient) lookupProject(name string, parentProjectId string) ([]Project, error) {
+	return findAll(client.ProjectsIter(), func(project Project) bool {
+		return !project.IsArchived && project.Name == name && project.ParentProjectId == parentProjectId
+	})
+}
+
+func (client *ApiClient) lookupTemplate(name string) ([]Template, error) {
+	return findAll(client.TemplatesIter(), func(template Template) bool {
+		return !template.IsDeleted && template.Name == name
+	})
+}
+
+func (client *ApiClient) lookupCredentials(name string, projectId string) ([]Credentials, error) {
+	return findAll(client.CloudCredentialsIter(), func(credentials Credentials) bool {
+		return credentials.Name == name && credentials.ProjectId == projectId
+	})
+}
+
+func (client *ApiClient) lookupTeam(name string) ([]Team, error) {
+	return findAll(paginate(client.teamPages(name)), func(team Team) bool {
+		return team.Name == name
+	})
+}
+
+func (client *ApiClient) lookupEnvironment(name string, projectId string) ([]Environment, error) {
+	pages := offsetPages[Environment](client, "/environments", map[string]string{"projectId": projectId})
+
+	return findAll(paginate(pages), func(environment Environment) bool {
+		return (environment.IsArchived == nil || !*environment.IsArchived) && environment.Name == name
+	})
+}
@@ -0,0 +1,281 @@
+package client_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/env0/terraform-provider-env0/client"
+	"github.com/env0/terraform-provider-env0/client/http"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Reconcile ambiguous creates", func() {
+	const name = "name0"
+
+	// Objects created before the create request was sent are never adopted.
+	const createdBefore = "2020-01-01T00:00:00.000Z"
+
+	var gatewayTimeout = http.NewMockFailedResponseError(504)
+
+	var createdAfter string
+
+	organizationParams := map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}
+
+	BeforeEach(func() {
+		createdAfter = time.Now().UTC().Format(time.RFC3339Nano)
+	})
+
+	Describe("Project", func() {
+		var existing, unrelated Project
+
+		BeforeEach(func() {
+			existing = Project{Id: "id0", Name: name, ParentProjectId: "parent0", CreatedAt: createdAfter}
+			// A project with the same name that existed before the create request.
+			unrelated = Project{Id: "id1", Name: name, ParentProjectId: "parent0", CreatedAt: createdBefore}
+
+			mockOrganizationIdCall().Times(1)
+		})
+
+		expectProjects := func(projects ...Project) *gomock.Call {
+			return mockHttpClient.EXPECT().Get("/projects", organizationParams, gomock.Any()).Times(1).Do(func(path string, request any, response *[]Project) {
+				*response = projects
+			})
+		}
+
+		It("Should send only the create request if it succeeds", func() {
+			mockHttpClient.EXPECT().Post("/projects", gomock.Any(), gomock.Any()).Times(1).Do(func(path string, request any, response *Project) {
+				*response = existing
+			})
+
+			project, err := apiClient.ProjectCreate(ProjectCreatePayload{Name: name, ParentProjectId: "parent0"})
+			Expect(err).To(BeNil())
+			Expect(project).To(Equal(existing))
+		})
+
+		Describe("Failed create request", func() {
+			BeforeEach(func() {
+				mockHttpClient.EXPECT().Post("/projects", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+			})
+
+			It("Should adopt the project if it was created", func() {
+				expectProjects(
+					Project{Id: "other", Name: "other", CreatedAt: createdAfter},
+					Project{Id: "archived", Name: name, ParentProjectId: "parent0", IsArchived: true, CreatedAt: createdAfter},
+					unrelated,
+					existing,
+				)
+
+				project, err := apiClient.ProjectCreate(ProjectCreatePayload{Name: name, ParentProjectId: "parent0"})
+				Expect(err).To(BeNil())
+				Expect(project).To(Equal(existing))
+			})
+
+			It("Should return the error if the project wasn't created", func() {
+				// Same name, different parent.
+				expectProjects(unrelated, Project{Id: "id2", Name: name, CreatedAt: createdAfter})
+
+				_, err := apiClient.ProjectCreate(ProjectCreatePayload{Name: name, ParentProjectId: "parent0"})
+				Expect(err).To(Equal(gatewayTimeout))
+			})
+
+			It("Should not adopt a project without a creation time", func() {
+				expectProjects(Project{Id: "id2", Name: name, ParentProjectId: "parent0"})
+
+				_, err := apiClient.ProjectCreate(ProjectCreatePayload{Name: name, ParentProjectId: "parent0"})
+				Expect(err).To(Equal(gatewayTimeout))
+			})
+
+			It("Should return the error if more than one project was created", func() {
+				expectProjects(existing, Project{Id: "id2", Name: name, ParentProjectId: "parent0", CreatedAt: createdAfter})
+
+				_, err := apiClient.ProjectCreate(ProjectCreatePayload{Name: name, ParentProjectId: "parent0"})
+				Expect(err).To(Equal(gatewayTimeout))
+			})
+
+			It("Should return the error if the lookup fails", func() {
+				mockHttpClient.EXPECT().Get("/projects", organizationParams, gomock.Any()).Times(1).Return(errors.New("lookup error"))
+
+				_, err := apiClient.ProjectCreate(ProjectCreatePayload{Name: name, ParentProjectId: "parent0"})
+				Expect(err).To(Equal(gatewayTimeout))
+			})
+		})
+	})
+
+	Describe("Template", func() {
+		var existing Template
+
+		BeforeEach(func() {
+			existing = Template{Id: "id0", Name: name, CreatedAt: createdAfter}
+
+			mockOrganizationIdCall().Times(1)
+		})
+
+		expectTemplates := func(templates ...Template) *gomock.Call {
+			return mockHttpClient.EXPECT().Get("/blueprints", organizationParams, gomock.Any()).Times(1).Do(func(path string, request any, response *[]Template) {
+				*response = templates
+			})
+		}
+
+		It("Should adopt the template if it was created", func() {
+			mockHttpClient.EXPECT().Post("/blueprints", gomock.Any(), gomock.Any()).Times(1).Return(errors.New("connection reset by peer"))
+			expectTemplates(Template{Id: "deleted", Name: name, IsDeleted: true, CreatedAt: createdAfter}, existing)
+
+			template, err := apiClient.TemplateCreate(TemplateCreatePayload{Name: name})
+			Expect(err).To(BeNil())
+			Expect(template).To(Equal(existing))
+		})
+
+		It("Should return the error if the template wasn't created", func() {
+			mockHttpClient.EXPECT().Post("/blueprints", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+			expectTemplates(Template{Id: "id1", Name: name, CreatedAt: createdBefore}, Template{Id: "other", Name: "other", CreatedAt: createdAfter})
+
+			_, err := apiClient.TemplateCreate(TemplateCreatePayload{Name: name})
+			Expect(err).To(Equal(gatewayTimeout))
+		})
+
+		It("Should not look up the template when the create fails with a client error", func() {
+			badRequest := http.NewMockFailedResponseError(400)
+
+			mockHttpClient.EXPECT().Post("/blueprints", gomock.Any(), gomock.Any()).Times(1).Return(badRequest)
+
+			_, err := apiClient.TemplateCreate(TemplateCreatePayload{Name: name})
+			Expect(err).To(Equal(badRequest))
+		})
+	})
+
+	Describe("Credentials", func() {
+		var existing Credentials
+
+		BeforeEach(func() {
+			existing = Credentials{Id: "id0", Name: name, Type: string(AwsAssumedRoleCredentialsType), CreatedAt: createdAfter}
+
+			mockOrganizationIdCall().Times(1)
+			mockHttpClient.EXPECT().Post("/credentials", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+		})
+
+		expectCredentials := func(credentials ...Credentials) *gomock.Call {
+			return mockHttpClient.EXPECT().Get("/credentials", organizationParams, gomock.Any()).Times(1).Do(func(path string, request any, response *[]Credentials) {
+				*response = credentials
+			})
+		}
+
+		It("Should adopt the cloud credentials if they were created", func() {
+			expectCredentials(Credentials{Id: "project", Name: name, ProjectId: "project0", CreatedAt: createdAfter}, existing)
+
+			credentials, err := apiClient.CredentialsCreate(&AwsCredentialsCreatePayload{Name: name, Type: AwsAssumedRoleCredentialsType})
+			Expect(err).To(BeNil())
+			Expect(credentials).To(Equal(existing))
+		})
+
+		It("Should adopt the kubernetes credentials if they were created", func() {
+			expectCredentials(existing)
+
+			credentials, err := apiClient.KubernetesCredentialsCreate(&KubernetesCredentialsCreatePayload{Name: name, Type: KubeconfigCredentialsType})
+			Expect(err).To(BeNil())
+			Expect(*credentials).To(Equal(existing))
+		})
+
+		It("Should return the error if the credentials weren't created", func() {
+			expectCredentials(Credentials{Id: "id1", Name: name, CreatedAt: createdBefore})
+
+			_, err := apiClient.CredentialsCreate(&AwsCredentialsCreatePayload{Name: name, Type: AwsAssumedRoleCredentialsType})
+			Expect(err).To(Equal(gatewayTimeout))
+		})
+	})
+
+	Describe("Team", func() {
+		var existing Team
+
+		BeforeEach(func() {
+			existing = Team{Id: "id0", Name: name, CreatedAt: createdAfter}
+
+			mockOrganizationIdCall().Times(1)
+			mockHttpClient.EXPECT().Post("/teams", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+		})
+
+		expectTeams := func(teams ...Team) *gomock.Call {
+			return mockHttpClient.EXPECT().
+				Get("/teams/organizations/"+organizationId, map[string]string{"limit": "100", "name": name}, gomock.Any()).
+				Times(1).
+				Do(func(path string, request any, response *PaginatedTeamsResponse) {
+					*response = PaginatedTeamsResponse{Teams: teams}
+				})
+		}
+
+		It("Should adopt the team if it was created", func() {
+			// The name filter may match partially.
+			expectTeams(Team{Id: "id1", Name: name + "-other", CreatedAt: createdAfter}, existing)
+
+			team, err := apiClient.TeamCreate(TeamCreatePayload{Name: name})
+			Expect(err).To(BeNil())
+			Expect(team).To(Equal(existing))
+		})
+
+		It("Should return the error if the team wasn't created", func() {
+			expectTeams()
+
+			_, err := apiClient.TeamCreate(TeamCreatePayload{Name: name})
+			Expect(err).To(Equal(gatewayTimeout))
+		})
+	})
+
+	Describe("Environment", func() {
+		const projectId = "project0"
+
+		var existing, unrelated Environment
+
+		BeforeEach(func() {
+			existing = Environment{Id: "id0", Name: name, ProjectId: projectId, CreatedAt: createdAfter}
+			// An environment with the same name that existed before the create request.
+			unrelated = Environment{Id: "id1", Name: name, ProjectId: projectId, CreatedAt: createdBefore}
+		})
+
+		expectEnvironments := func(environments ...Environment) *gomock.Call {
+			return mockHttpClient.EXPECT().
+				Get("/environments", map[string]string{"projectId": projectId, "limit": "100", "offset": "0"}, gomock.Any()).
+				Times(1).
+				Do(func(path string, request any, response *[]Environment) {
+					*response = environments
+				})
+		}
+
+		It("Should adopt the environment if it was created", func() {
+			mockHttpClient.EXPECT().Post("/environments", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+			expectEnvironments(Environment{Id: "archived", Name: name, ProjectId: projectId, IsArchived: new(true), CreatedAt: createdAfter}, unrelated, existing)
+
+			environment, err := apiClient.EnvironmentCreate(EnvironmentCreate{Name: name, ProjectId: projectId})
+			Expect(err).To(BeNil())
+			Expect(environment).To(Equal(existing))
+		})
+
+		It("Should adopt the environment without a template if it was created", func() {
+			mockOrganizationIdCall().Times(1)
+			mockHttpClient.EXPECT().Post("/environments/without-template", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+			expectEnvironments(existing)
+
+			environment, err := apiClient.EnvironmentCreateWithoutTemplate(EnvironmentCreateWithoutTemplate{
+				EnvironmentCreate: EnvironmentCreate{Name: name, ProjectId: projectId},
+			})
+			Expect(err).To(BeNil())
+			Expect(environment).To(Equal(existing))
+		})
+
+		It("Should return the error if the environment wasn't created", func() {
+			mockHttpClient.EXPECT().Post("/environments", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+			expectEnvironments(unrelated, Environment{Id: "other", Name: "other", ProjectId: projectId, CreatedAt: createdAfter})
+
+			_, err := apiClient.EnvironmentCreate(EnvironmentCreate{Name: name, ProjectId: projectId})
+			Expect(err).To(Equal(gatewayTimeout))
+		})
+
+		It("Should return the error if more than one environment was created", func() {
+			mockHttpClient.EXPECT().Post("/environments", gomock.Any(), gomock.Any()).Times(1).Return(gatewayTimeout)
+			expectEnvironments(existing, Environment{Id: "id2", Name: name, ProjectId: projectId, CreatedAt: createdAfter})
+
+			_, err := apiClient.EnvironmentCreate(EnvironmentCreate{Name: name, ProjectId: projectId})
+			Expect(err).To(Equal(gatewayTimeout))
+		})
+	})
+})
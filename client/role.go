@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type RoleCreatePayload struct {
 	Name           string   `json:"name"`
 	OrganizationId string   `json:"organizationId"`
@@ -62,15 +64,10 @@ func (client *ApiClient) RoleUpdate(id string, payload RoleUpdatePayload) (*Role
 }
 
 func (client *ApiClient) Roles() ([]Role, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []Role
-	if err := client.http.Get("/roles", map[string]string{"organizationId": organizationId}, &result); err != nil {
-		return nil, err
-	}
+	return cachedList(client.cache, client.cacheKey("roles"), func() ([]Role, error) { return collect(client.RolesIter()) })
+}
 
-	return result, err
+// RolesIter returns an iterator over all the roles. The endpoint isn't paginated, so they are fetched in a single request. Unlike Roles(), results are not cached.
+func (client *ApiClient) RolesIter() iter.Seq2[Role, error] {
+	return paginate(organizationPages[Role](client, organizationEndpoint("/roles"), singlePage[Role]))
 }
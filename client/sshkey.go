@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type SshKey struct {
 	User           User   `json:"user"`
 	UserId         string `json:"userId"`
@@ -53,17 +55,10 @@ func (client *ApiClient) SshKeyDelete(id string) error {
 }
 
 func (client *ApiClient) SshKeys() ([]SshKey, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []SshKey
-
-	err = client.http.Get("/ssh-keys", map[string]string{"organizationId": organizationId}, &result)
-	if err != nil {
-		return nil, err
-	}
+	return collect(client.SshKeysIter())
+}
 
-	return result, err
+// SshKeysIter returns an iterator over all the ssh keys. The endpoint isn't paginated, so they are fetched in a single request.
+func (client *ApiClient) SshKeysIter() iter.Seq2[SshKey, error] {
+	return paginate(organizationPages[SshKey](client, organizationEndpoint("/ssh-keys"), singlePage[SshKey]))
 }
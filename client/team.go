@@ -1,11 +1,15 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"iter"
+	"strconv"
+)
 
 type TeamCreatePayload struct {
 	Name           string `json:"name"`
 	Description    string `json:"description"`
-	OrganizationId string `json:"organizationId"`
+	OrganizationId string `json:"organizationId" tfschema:"-"`
 }
 
 type TeamUpdatePayload struct {
@@ -20,6 +24,7 @@ type Team struct {
 	Description    string `json:"description"`
 	OrganizationId string `json:"organizationId"`
 	Users          []User `json:"users"`
+	CreatedAt      string `json:"createdAt"      tfschema:"-"`
 }
 
 type PaginatedTeamsResponse struct {
@@ -43,14 +48,17 @@ func (client *ApiClient) TeamCreate(payload TeamCreatePayload) (Team, error) {
 
 	payload.OrganizationId = organizationId
 
-	var result Team
+	return createOrAdopt(client, "team", payload.Name, func() (Team, error) {
+		var result Team
 
-	err = client.http.Post("/teams", payload, &result)
-	if err != nil {
-		return Team{}, err
-	}
+		if err := client.http.Post("/teams", payload, &result); err != nil {
+			return Team{}, err
+		}
 
-	return result, nil
+		return result, nil
+	}, func() ([]Team, error) {
+		return client.lookupTeam(payload.Name)
+	}, func(object Team) string { return object.CreatedAt })
 }
 
 func (client *ApiClient) Team(id string) (Team, error) {
@@ -83,43 +91,45 @@ func (client *ApiClient) TeamUpdate(id string, payload TeamUpdatePayload) (Team,
 	return result, nil
 }
 
-func (client *ApiClient) GetTeams(name string) ([]Team, error) {
-	params := map[string]string{"limit": "100"}
-	if name != "" {
-		params["name"] = name
-	}
+func (client *ApiClient) teamPages(name string) pageFetcher[Team] {
+	return func(token string) ([]Team, string, error) {
+		params := map[string]string{"limit": strconv.Itoa(limit)}
+		if name != "" {
+			params["name"] = name
+		}
 
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
+		if token != "" {
+			params["offset"] = token
+		}
 
-	var teams []Team
+		organizationId, err := client.OrganizationId()
+		if err != nil {
+			return nil, "", err
+		}
 
-	for {
 		var res PaginatedTeamsResponse
 
 		if err := client.http.Get("/teams/organizations/"+organizationId, params, &res); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
-		teams = append(teams, res.Teams...)
-
-		nextPageKey := res.NextPageKey
-		if nextPageKey == "" {
-			break
-		}
-
-		params["offset"] = nextPageKey
+		return res.Teams, res.NextPageKey, nil
 	}
+}
 
-	return teams, nil
+func (client *ApiClient) GetTeams(name string) ([]Team, error) {
+	return collect(paginate(client.teamPages(name)))
 }
 
 func (client *ApiClient) Teams() ([]Team, error) {
-	return client.memoizedGetTeams("")
+	return cachedList(client.cache, client.cacheKey("teams"), func() ([]Team, error) { return client.GetTeams("") })
 }
 
 func (client *ApiClient) TeamsByName(name string) ([]Team, error) {
-	return client.memoizedGetTeams(name)
+	return cachedList(client.cache, client.cacheKey("teams:"+name), func() ([]Team, error) { return client.GetTeams(name) })
+}
+
+// TeamsIter returns an iterator over all the teams. Unlike Teams(), results are not cached.
+func (client *ApiClient) TeamsIter() iter.Seq2[Team, error] {
+	return paginate(client.teamPages(""))
 }
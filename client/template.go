@@ -7,6 +7,7 @@ package client
 import (
 	"errors"
 	"fmt"
+	"iter"
 	"strconv"
 	"strings"
 
@@ -87,7 +88,7 @@ type TemplateCreatePayload struct {
 	GithubInstallationId int              `json:"githubInstallationId,omitempty"`
 	VcsConnectionId      string           `json:"vcsConnectionId,omitempty"`
 	Revision             string           `json:"revision"`
-	OrganizationId       string           `json:"organizationId"`
+	OrganizationId       string           `json:"organizationId"                 tfschema:"-"`
 	TerraformVersion     string           `json:"terraformVersion,omitempty"`
 	TerragruntVersion    string           `json:"terragruntVersion,omitempty"`
 	OpentofuVersion      string           `json:"opentofuVersion,omitempty"`
@@ -233,6 +234,14 @@ func (payload *TemplateCreatePayload) Invalidate() error {
 }
 
 func (client *ApiClient) TemplateCreate(payload TemplateCreatePayload) (Template, error) {
+	return createOrAdopt(client, "template", payload.Name, func() (Template, error) {
+		return client.templateCreate(payload)
+	}, func() ([]Template, error) {
+		return client.lookupTemplate(payload.Name)
+	}, func(object Template) string { return object.CreatedAt })
+}
+
+func (client *ApiClient) templateCreate(payload TemplateCreatePayload) (Template, error) {
 	organizationId, err := client.OrganizationId()
 	if err != nil {
 		return Template{}, err
@@ -284,19 +293,12 @@ func (client *ApiClient) TemplateUpdate(id string, payload TemplateCreatePayload
 }
 
 func (client *ApiClient) Templates() ([]Template, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []Template
-
-	err = client.http.Get("/blueprints", map[string]string{"organizationId": organizationId}, &result)
-	if err != nil {
-		return nil, err
-	}
+	return cachedList(client.cache, client.cacheKey("templates"), func() ([]Template, error) { return collect(client.TemplatesIter()) })
+}
 
-	return result, err
+// TemplatesIter returns an iterator over all the templates. Pages are fetched lazily, and results are not cached (unlike Templates()).
+func (client *ApiClient) TemplatesIter() iter.Seq2[Template, error] {
+	return paginate(organizationPages[Template](client, organizationEndpoint("/blueprints"), offsetPages[Template]))
 }
 
 func (client *ApiClient) TemplatesByName(name string) ([]Template, error) {
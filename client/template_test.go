@@ -68,7 +68,7 @@ var _ = Describe("Templates Client", func() {
 		BeforeEach(func() {
 			mockOrganizationIdCall()
 
-			expectedPayload := map[string]string{"organizationId": organizationId}
+			expectedPayload := map[string]string{"organizationId": organizationId, "limit": "100", "offset": "0"}
 			httpCall = mockHttpClient.EXPECT().
 				Get("/blueprints", expectedPayload, gomock.Any()).
 				Do(func(path string, request any, response *[]Template) {
@@ -1,5 +1,7 @@
 package client
 
+import "iter"
+
 type OrganizationUser struct {
 	User   User   `json:"user"`
 	Role   string `json:"role"`
@@ -7,15 +9,12 @@ type OrganizationUser struct {
 }
 
 func (client *ApiClient) Users() ([]OrganizationUser, error) {
-	organizationId, err := client.OrganizationId()
-	if err != nil {
-		return nil, err
-	}
-
-	var result []OrganizationUser
-	if err := client.http.Get("/organizations/"+organizationId+"/users", nil, &result); err != nil {
-		return nil, err
-	}
+	return cachedList(client.cache, client.cacheKey("users"), func() ([]OrganizationUser, error) { return collect(client.UsersIter()) })
+}
 
-	return result, nil
+// UsersIter returns an iterator over all the users of the organization. Pages are fetched lazily, and results are not cached (unlike Users()).
+func (client *ApiClient) UsersIter() iter.Seq2[OrganizationUser, error] {
+	return paginate(organizationPages[OrganizationUser](client, func(organizationId string) (string, map[string]string) {
+		return "/organizations/" + organizationId + "/users", nil
+	}, offsetPages[OrganizationUser]))
 }
@@ -98,8 +98,8 @@ func getCloudConfigurationFromApi(apiClient client.ApiClientInterface, id string
 	return &cloudAccountCopy, nil
 }
 
-func createCloudConfiguration(d *schema.ResourceData, meta any, provider string) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+func createCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta any, provider string) diag.Diagnostics {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var createPayload client.CloudAccountCreatePayload
 
@@ -117,7 +117,7 @@ func createCloudConfiguration(d *schema.ResourceData, meta any, provider string)
 
 	cloudAccount, err := apiClient.CloudAccountCreate(&createPayload)
 	if err != nil {
-		return diag.Errorf("failed to create a cloud configuration: %v", err)
+		return ApiFailure("failed to create a cloud configuration", d, err)
 	}
 
 	d.Set("health", cloudAccount.Health)
@@ -128,7 +128,7 @@ func createCloudConfiguration(d *schema.ResourceData, meta any, provider string)
 }
 
 func readCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	cloudAccount, err := getCloudConfigurationFromApi(apiClient, d.Id())
 	if err != nil {
@@ -146,8 +146,8 @@ func readCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta an
 	return nil
 }
 
-func updateCloudConfiguration(d *schema.ResourceData, meta any, provider string) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+func updateCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta any, provider string) diag.Diagnostics {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var updatePayload client.CloudAccountUpdatePayload
 
@@ -164,7 +164,7 @@ func updateCloudConfiguration(d *schema.ResourceData, meta any, provider string)
 
 	cloudAccount, err := apiClient.CloudAccountUpdate(d.Id(), &updatePayload)
 	if err != nil {
-		return diag.Errorf("failed to update cloud configuration: %v", err)
+		return ApiFailure("failed to update cloud configuration", d, err)
 	}
 
 	d.Set("health", cloudAccount.Health)
@@ -173,7 +173,7 @@ func updateCloudConfiguration(d *schema.ResourceData, meta any, provider string)
 }
 
 func deleteCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.CloudAccountDelete(d.Id()); err != nil {
 		return diag.Errorf("failed to delete cloud configuration: %v", err)
@@ -183,7 +183,7 @@ func deleteCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func importCloudConfiguration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	cloudAccount, err := getCloudConfigurationFromApi(apiClient, d.Id())
 	if err != nil {
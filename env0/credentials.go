@@ -50,8 +50,8 @@ var credentialsTypeToPrefixList map[CloudType][]string = map[CloudType][]string{
 	OCI_TYPE:        {string(client.OciApiKeyCredentialsType)},
 }
 
-func getCredentialsByName(name string, prefixList []string, meta any) (client.Credentials, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getCredentialsByName(ctx context.Context, name string, prefixList []string, meta any) (client.Credentials, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialsList, err := apiClient.CloudCredentialsList()
 	if err != nil {
@@ -77,8 +77,8 @@ func getCredentialsByName(name string, prefixList []string, meta any) (client.Cr
 	return foundCredentials[0], nil
 }
 
-func getCredentialsById(id string, prefixList []string, meta any) (client.Credentials, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getCredentialsById(ctx context.Context, id string, prefixList []string, meta any) (client.Credentials, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentials, err := apiClient.CloudCredentials(id)
 	if err != nil {
@@ -101,16 +101,18 @@ func getCredentials(ctx context.Context, id string, prefixList []string, meta an
 	if err == nil {
 		tflog.Info(ctx, "Resolving credentials by id", map[string]any{"id": id})
 
-		return getCredentialsById(id, prefixList, meta)
+		return getCredentialsById(ctx, id, prefixList, meta)
 	} else {
 		tflog.Info(ctx, "Resolving credentials by name", map[string]any{"name": id})
 
-		return getCredentialsByName(id, prefixList, meta)
+		return getCredentialsByName(ctx, id, prefixList, meta)
 	}
 }
 
 func resourceCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -124,7 +126,9 @@ func resourceCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceCredentialsRead(cloudType CloudType) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		apiClient := meta.(client.ApiClientInterface)
+		meta = withOrganizationId(d, meta)
+
+		apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 		credentials, err := apiClient.CloudCredentials(d.Id())
 		if err != nil {
@@ -141,6 +145,8 @@ func resourceCredentialsRead(cloudType CloudType) schema.ReadContextFunc {
 
 func resourceCredentialsImport(cloudType CloudType) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		meta = withOrganizationId(d, meta)
+
 		credentials, err := getCredentials(ctx, d.Id(), credentialsTypeToPrefixList[cloudType], meta)
 		if err != nil {
 			if _, ok := err.(*client.NotFoundError); ok {
@@ -65,7 +65,7 @@ func dataAgentPool() *schema.Resource {
 }
 
 func dataAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var agentPool *client.AgentPool
 
@@ -78,7 +78,7 @@ func dataAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta any) di
 			return diag.Errorf("could not read agent pool: %v", err)
 		}
 	} else {
-		agentPool, err = getAgentPoolByName(d.Get("name").(string), meta)
+		agentPool, err = getAgentPoolByName(ctx, d.Get("name").(string), meta)
 		if err != nil {
 			return diag.Errorf("could not read agent pool: %v", err)
 		}
@@ -29,7 +29,7 @@ func dataAgentValues() *schema.Resource {
 }
 
 func dataAgentValuesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	agentKey := d.Get("agent_key").(string)
 
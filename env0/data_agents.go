@@ -34,7 +34,7 @@ func dataAgents() *schema.Resource {
 }
 
 func agentsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	agents, err := apiClient.Agents()
 	if err != nil {
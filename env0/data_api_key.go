@@ -41,12 +41,12 @@ func dataApiKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	id, ok := d.GetOk("id")
 	if ok {
-		apiKey, err = getApiKeyById(id.(string), meta)
+		apiKey, err = getApiKeyById(ctx, id.(string), meta)
 		if err != nil {
 			return diag.Errorf("could not read api key: %v", err)
 		}
 	} else {
-		apiKey, err = getApiKeyByName(d.Get("name").(string), meta)
+		apiKey, err = getApiKeyByName(ctx, d.Get("name").(string), meta)
 		if err != nil {
 			return diag.Errorf("could not read api key: %v", err)
 		}
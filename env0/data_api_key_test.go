@@ -1,11 +1,15 @@
 package env0
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
 	"github.com/env0/terraform-provider-env0/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestApiKeyDataSource(t *testing.T) {
@@ -61,6 +65,7 @@ func TestApiKeyDataSource(t *testing.T) {
 	mockListApiKeysCall := func(returnValue []client.ApiKey) func(mockFunc *client.MockApiClientInterface) {
 		return func(mock *client.MockApiClientInterface) {
 			mock.EXPECT().ApiKeys().AnyTimes().Return(returnValue, nil)
+			mock.EXPECT().ApiKeysIter().AnyTimes().Return(seqOf(returnValue...))
 		}
 	}
 
@@ -106,3 +111,27 @@ func TestApiKeyDataSource(t *testing.T) {
 		)
 	})
 }
+
+func TestGetApiKeyByIdStopsOnceFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mock := client.NewMockApiClientInterface(ctrl)
+
+	yielded := 0
+	apiKeys := func(yield func(client.ApiKey, error) bool) {
+		for _, id := range []string{"id0", "id1", "id2"} {
+			yielded++
+
+			if !yield(client.ApiKey{Id: id}, nil) {
+				return
+			}
+		}
+	}
+
+	mock.EXPECT().WithContext(gomock.Any()).Return(mock)
+	mock.EXPECT().ApiKeysIter().Return(apiKeys)
+
+	apiKey, err := getApiKeyById(context.Background(), "id1", mock)
+	require.NoError(t, err)
+	assert.Equal(t, "id1", apiKey.Id)
+	assert.Equal(t, 2, yielded)
+}
@@ -47,7 +47,7 @@ func dataCloudCredentials() *schema.Resource {
 }
 
 func dataCloudCredentialsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialsList, err := apiClient.CloudCredentialsList()
 	if err != nil {
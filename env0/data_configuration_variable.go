@@ -133,7 +133,7 @@ func dataConfigurationVariableRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	variable, err := getConfigurationVariable(params, meta)
+	variable, err := getConfigurationVariable(ctx, params, meta)
 	if err != nil {
 		return err
 	}
@@ -186,8 +186,8 @@ func getScopeAndId(d *schema.ResourceData) (client.Scope, string) {
 	return scope, scopeId
 }
 
-func getConfigurationVariable(params ConfigurationVariableParams, meta any) (client.ConfigurationVariable, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getConfigurationVariable(ctx context.Context, params ConfigurationVariableParams, meta any) (client.ConfigurationVariable, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 	id, idOk := params.Id, params.Id != ""
 
 	if idOk {
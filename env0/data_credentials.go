@@ -39,7 +39,7 @@ func dataCredentialsRead(cloudType CloudType) schema.ReadContextFunc {
 
 		id, ok := d.GetOk("id")
 		if ok {
-			credentials, err = getCredentialsById(id.(string), prefixList, meta)
+			credentials, err = getCredentialsById(ctx, id.(string), prefixList, meta)
 			if err != nil {
 				return diag.Errorf("could not query %s credentials by id: %v", cloudType, err)
 			}
@@ -49,7 +49,7 @@ func dataCredentialsRead(cloudType CloudType) schema.ReadContextFunc {
 				return diag.Errorf("either 'name' or 'id' must be specified")
 			}
 
-			credentials, err = getCredentialsByName(name.(string), prefixList, meta)
+			credentials, err = getCredentialsByName(ctx, name.(string), prefixList, meta)
 			if err != nil {
 				return diag.Errorf("could not query %s credentials by name: %v", cloudType, err)
 			}
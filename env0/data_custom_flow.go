@@ -36,14 +36,14 @@ func dataCustomFlowRead(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	id, ok := d.GetOk("id")
 	if ok {
-		customFlow, err = meta.(client.ApiClientInterface).CustomFlow(id.(string))
+		customFlow, err = meta.(client.ApiClientInterface).WithContext(ctx).CustomFlow(id.(string))
 		if err != nil {
 			return diag.Errorf("failed to get custom flow by id: %v", err)
 		}
 	} else {
 		name := d.Get("name")
 
-		customFlow, err = getCustomFlowByName(name.(string), meta)
+		customFlow, err = getCustomFlowByName(ctx, name.(string), meta)
 		if err != nil {
 			return diag.Errorf("failed to get custom flow by name: %v", err)
 		}
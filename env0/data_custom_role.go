@@ -36,9 +36,9 @@ func dataCustomRoleRead(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	id, ok := d.GetOk("id")
 	if ok {
-		role, err = getCustomRoleById(id.(string), meta)
+		role, err = getCustomRoleById(ctx, id.(string), meta)
 	} else {
-		role, err = getCustomRoleByName(d.Get("name").(string), meta)
+		role, err = getCustomRoleByName(ctx, d.Get("name").(string), meta)
 	}
 
 	if err != nil {
@@ -27,7 +27,7 @@ func dataCustomRoles() *schema.Resource {
 }
 
 func dataCustomRolesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	roles, err := apiClient.Roles()
 	if err != nil {
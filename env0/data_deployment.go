@@ -0,0 +1,189 @@
+package env0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataDeployment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataDeploymentRead,
+
+		Description: "a deployment of an environment, by id or the last deployment of an environment",
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Description:  "the id of the deployment",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "environment_id"},
+			},
+			"environment_id": {
+				Type:         schema.TypeString,
+				Description:  "the id of the environment. The last deployment of the environment is returned",
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "environment_id"},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "the status of the deployment (E.g. QUEUED, IN_PROGRESS, WAITING_FOR_USER, SUCCESS, FAILURE)",
+				Computed:    true,
+			},
+			"is_finished": {
+				Type:        schema.TypeBool,
+				Description: "true if the deployment has finished (successfully or not)",
+				Computed:    true,
+			},
+			"is_successful": {
+				Type:        schema.TypeBool,
+				Description: "true if the deployment has finished successfully",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "the type of the deployment (E.g. deploy, destroy, prPlan, driftDetection)",
+				Computed:    true,
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Description: "the id of the deployed template",
+				Computed:    true,
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Description: "the deployed revision",
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "the time the deployment was created (queued)",
+				Computed:    true,
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Description: "the time the deployment started. Empty if it didn't start",
+				Computed:    true,
+			},
+			"finished_at": {
+				Type:        schema.TypeString,
+				Description: "the time the deployment finished. Empty if it didn't finish",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "the error message of a failed deployment. Empty if the deployment didn't fail",
+				Computed:    true,
+			},
+			"plan_summary": {
+				Type:        schema.TypeList,
+				Description: "the summary of the plan of the deployment. Empty if there's no plan (yet)",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"added": {
+							Type:        schema.TypeInt,
+							Description: "the number of resources to add",
+							Computed:    true,
+						},
+						"changed": {
+							Type:        schema.TypeInt,
+							Description: "the number of resources to change",
+							Computed:    true,
+						},
+						"destroyed": {
+							Type:        schema.TypeInt,
+							Description: "the number of resources to destroy",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// deploymentErrorMessage returns the message of the error of a deployment. The error is either a string, or an object
+// with a message (E.g. {"message": "apply failed"}). Any other error is returned json encoded.
+func deploymentErrorMessage(deploymentError json.RawMessage) string {
+	deploymentError = bytes.TrimSpace(deploymentError)
+	if len(deploymentError) == 0 || bytes.Equal(deploymentError, []byte("null")) {
+		return ""
+	}
+
+	var message string
+	if err := json.Unmarshal(deploymentError, &message); err == nil {
+		return message
+	}
+
+	var withMessage struct {
+		Message string `json:"message"`
+	}
+
+	if err := json.Unmarshal(deploymentError, &withMessage); err == nil && withMessage.Message != "" {
+		return withMessage.Message
+	}
+
+	return string(deploymentError)
+}
+
+func dataDeploymentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
+
+	deploymentId := d.Get("id").(string)
+
+	if environmentId, ok := d.GetOk("environment_id"); ok {
+		environment, err := apiClient.Environment(environmentId.(string))
+		if err != nil {
+			return DataGetFailure("environment", environmentId, err)
+		}
+
+		if environment.LatestDeploymentLogId == "" {
+			return diag.Errorf("environment '%s' has no deployments", environmentId)
+		}
+
+		deploymentId = environment.LatestDeploymentLogId
+	}
+
+	deployment, err := apiClient.EnvironmentDeploymentLog(deploymentId)
+	if err != nil {
+		return DataGetFailure("deployment", deploymentId, err)
+	}
+
+	d.SetId(deploymentId)
+	d.Set("status", deployment.Status)
+	d.Set("is_successful", deployment.Status == "SUCCESS")
+	d.Set("is_finished", isDeploymentFinished(deployment.Status))
+	d.Set("type", deployment.Type)
+	d.Set("template_id", deployment.BlueprintId)
+	d.Set("revision", deployment.BlueprintRevision)
+	d.Set("created_at", deployment.CreatedAt)
+	d.Set("started_at", deployment.StartedAt)
+	d.Set("finished_at", deployment.FinishedAt)
+	d.Set("error_message", deploymentErrorMessage(deployment.Error))
+
+	planSummary := []any{}
+	if deployment.PlanSummary != nil {
+		planSummary = append(planSummary, map[string]any{
+			"added":     deployment.PlanSummary.Added,
+			"changed":   deployment.PlanSummary.Changed,
+			"destroyed": deployment.PlanSummary.Destroyed,
+		})
+	}
+
+	d.Set("plan_summary", planSummary)
+
+	return nil
+}
+
+// isDeploymentFinished returns true if the deployment has finished (successfully or not).
+// An ABORTING deployment is still running (it's being aborted).
+func isDeploymentFinished(status string) bool {
+	return status == "SUCCESS" || (status != "ABORTING" && slices.Contains(failedDeploymentStatuses, status))
+}
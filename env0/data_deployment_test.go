@@ -0,0 +1,198 @@
+package env0
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentDataSource(t *testing.T) {
+	deployment := client.DeploymentLog{
+		Id:                "deployment-id",
+		BlueprintId:       "template-id",
+		BlueprintRevision: "main",
+		Type:              "deploy",
+		Status:            "FAILURE",
+		Error:             []byte(`{"message": "apply failed"}`),
+		CreatedAt:         "2024-01-01T00:00:00.000Z",
+		StartedAt:         "2024-01-01T00:01:00.000Z",
+		FinishedAt:        "2024-01-01T00:05:00.000Z",
+		PlanSummary:       &client.DeploymentPlanSummary{Added: 1, Changed: 2, Destroyed: 3},
+	}
+
+	inProgressDeployment := client.DeploymentLog{
+		Id:     "in-progress-deployment-id",
+		Type:   "deploy",
+		Status: "IN_PROGRESS",
+	}
+
+	abortingDeployment := client.DeploymentLog{
+		Id:     "aborting-deployment-id",
+		Type:   "deploy",
+		Status: "ABORTING",
+	}
+
+	environment := client.Environment{
+		Id:                    "environment-id",
+		LatestDeploymentLogId: deployment.Id,
+	}
+
+	resourceType := "env0_deployment"
+	resourceName := "test"
+	accessor := dataSourceAccessor(resourceType, resourceName)
+
+	getValidTestCase := func(input map[string]any) resource.TestCase {
+		return resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config: dataSourceConfigCreate(resourceType, resourceName, input),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(accessor, "id", deployment.Id),
+						resource.TestCheckResourceAttr(accessor, "status", deployment.Status),
+						resource.TestCheckResourceAttr(accessor, "is_finished", "true"),
+						resource.TestCheckResourceAttr(accessor, "is_successful", "false"),
+						resource.TestCheckResourceAttr(accessor, "type", deployment.Type),
+						resource.TestCheckResourceAttr(accessor, "template_id", deployment.BlueprintId),
+						resource.TestCheckResourceAttr(accessor, "revision", deployment.BlueprintRevision),
+						resource.TestCheckResourceAttr(accessor, "created_at", deployment.CreatedAt),
+						resource.TestCheckResourceAttr(accessor, "started_at", deployment.StartedAt),
+						resource.TestCheckResourceAttr(accessor, "finished_at", deployment.FinishedAt),
+						resource.TestCheckResourceAttr(accessor, "error_message", "apply failed"),
+						resource.TestCheckResourceAttr(accessor, "plan_summary.0.added", "1"),
+						resource.TestCheckResourceAttr(accessor, "plan_summary.0.changed", "2"),
+						resource.TestCheckResourceAttr(accessor, "plan_summary.0.destroyed", "3"),
+					),
+				},
+			},
+		}
+	}
+
+	t.Run("By id", func(t *testing.T) {
+		runUnitTest(t,
+			getValidTestCase(map[string]any{"id": deployment.Id}),
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().EnvironmentDeploymentLog(deployment.Id).AnyTimes().Return(&deployment, nil)
+			},
+		)
+	})
+
+	t.Run("Latest deployment of environment", func(t *testing.T) {
+		runUnitTest(t,
+			getValidTestCase(map[string]any{"environment_id": environment.Id}),
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().Environment(environment.Id).AnyTimes().Return(environment, nil)
+				mock.EXPECT().EnvironmentDeploymentLog(deployment.Id).AnyTimes().Return(&deployment, nil)
+			},
+		)
+	})
+
+	t.Run("In progress deployment", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: dataSourceConfigCreate(resourceType, resourceName, map[string]any{"id": inProgressDeployment.Id}),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(accessor, "status", inProgressDeployment.Status),
+							resource.TestCheckResourceAttr(accessor, "is_finished", "false"),
+							resource.TestCheckResourceAttr(accessor, "is_successful", "false"),
+							resource.TestCheckResourceAttr(accessor, "error_message", ""),
+							resource.TestCheckResourceAttr(accessor, "plan_summary.#", "0"),
+						),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().EnvironmentDeploymentLog(inProgressDeployment.Id).AnyTimes().Return(&inProgressDeployment, nil)
+			},
+		)
+	})
+
+	t.Run("Aborting deployment", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: dataSourceConfigCreate(resourceType, resourceName, map[string]any{"id": abortingDeployment.Id}),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(accessor, "status", abortingDeployment.Status),
+							resource.TestCheckResourceAttr(accessor, "is_finished", "false"),
+							resource.TestCheckResourceAttr(accessor, "is_successful", "false"),
+						),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().EnvironmentDeploymentLog(abortingDeployment.Id).AnyTimes().Return(&abortingDeployment, nil)
+			},
+		)
+	})
+
+	t.Run("Environment without deployments", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config:      dataSourceConfigCreate(resourceType, resourceName, map[string]any{"environment_id": environment.Id}),
+						ExpectError: regexp.MustCompile("environment 'environment-id' has no deployments"),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().Environment(environment.Id).AnyTimes().Return(client.Environment{Id: environment.Id}, nil)
+			},
+		)
+	})
+
+	t.Run("Throw error when no id or environment_id is supplied", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config:      dataSourceConfigCreate(resourceType, resourceName, map[string]any{}),
+						ExpectError: regexp.MustCompile("one of `environment_id,id` must be specified"),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {},
+		)
+	})
+}
+
+func TestDeploymentErrorMessage(t *testing.T) {
+	for _, testCase := range []struct {
+		name     string
+		error    string
+		expected string
+	}{
+		{"no error", "", ""},
+		{"null", "null", ""},
+		{"string", `"apply failed"`, "apply failed"},
+		{"message", `{"message": "apply failed", "code": 1}`, "apply failed"},
+		{"other", `{"code": 1}`, `{"code": 1}`},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, deploymentErrorMessage([]byte(testCase.error)))
+		})
+	}
+}
+
+func TestIsDeploymentFinished(t *testing.T) {
+	for status, expected := range map[string]bool{
+		"QUEUED":           false,
+		"IN_PROGRESS":      false,
+		"WAITING_FOR_USER": false,
+		"ABORTING":         false,
+		"SUCCESS":          true,
+		"FAILURE":          true,
+		"ABORTED":          true,
+		"CANCELLED":        true,
+	} {
+		t.Run(status, func(t *testing.T) {
+			assert.Equal(t, expected, isDeploymentFinished(status))
+		})
+	}
+}
@@ -85,6 +85,7 @@ func dataEnvironment() *schema.Resource {
 				Description: "the deployment log output. Returns a json string. It can be either a map of key-value, or an array of (in case of Terragrunt run-all) of moduleName and a map of key-value. Note: if the deployment is still in progress returns 'null'",
 				Computed:    true,
 			},
+			"outputs": environmentOutputsSchema("the outputs of the last deployment, parsed from 'output'. The outputs of a Terragrunt run-all deployment are flattened (one item per module output). The values of sensitive outputs are omitted (use the env0_environment_outputs data source)"),
 			"bitbucket_client_key": {
 				Type:        schema.TypeString,
 				Description: "Bitbucket client key",
@@ -132,7 +133,7 @@ func dataEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	id, ok := d.GetOk("id")
 	if ok {
-		environment, err = getEnvironmentById(id.(string), meta)
+		environment, err = getEnvironmentById(ctx, id.(string), meta)
 		if err != nil {
 			return err
 		}
@@ -140,7 +141,7 @@ func dataEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any)
 		name := d.Get("name").(string)
 		excludeArchived := d.Get("exclude_archived")
 
-		environment, err = getEnvironmentByName(meta, name, projectId, excludeArchived.(bool))
+		environment, err = getEnvironmentByName(ctx, meta, name, projectId, excludeArchived.(bool))
 		if err != nil {
 			return err
 		}
@@ -198,7 +199,7 @@ func dataEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	templateId := environment.LatestDeploymentLog.BlueprintId
 
-	template, err := getTemplateById(templateId, meta)
+	template, err := getTemplateById(ctx, templateId, meta)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,99 @@
+package env0
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataEnvironmentOutputs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataEnvironmentOutputsRead,
+
+		Description: "the outputs of a deployment of an environment (the last deployment by default). The outputs of a Terragrunt run-all deployment are flattened: the key of an output is 'module_name.output_name'",
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:        schema.TypeString,
+				Description: "the id of the environment",
+				Required:    true,
+			},
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Description: "the id of the deployment. Defaults to the last deployment of the environment",
+				Optional:    true,
+				Computed:    true,
+			},
+			"outputs": environmentOutputsSchema("the outputs of the deployment (one item per output). The values of sensitive outputs are omitted (see 'sensitive_values')"),
+			"values": {
+				Type:        schema.TypeMap,
+				Description: "the values of the non-sensitive outputs by key. Strings are returned as is, other values are json encoded (use jsondecode)",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"sensitive_values": {
+				Type:        schema.TypeMap,
+				Description: "the values of the sensitive outputs by key. Strings are returned as is, other values are json encoded (use jsondecode)",
+				Computed:    true,
+				Sensitive:   true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataEnvironmentOutputsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
+
+	environmentId := d.Get("environment_id").(string)
+
+	var output json.RawMessage
+
+	if deploymentId, ok := d.GetOk("deployment_id"); ok {
+		deployment, err := apiClient.EnvironmentDeploymentLog(deploymentId.(string))
+		if err != nil {
+			return DataGetFailure("environment deployment", deploymentId, err)
+		}
+
+		output = deployment.Output
+	} else {
+		environment, err := apiClient.Environment(environmentId)
+		if err != nil {
+			return DataGetFailure("environment", environmentId, err)
+		}
+
+		d.Set("deployment_id", environment.LatestDeploymentLogId)
+
+		output = environment.LatestDeploymentLog.Output
+	}
+
+	outputs, err := parseEnvironmentOutputs(output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]any{}
+	sensitiveValues := map[string]any{}
+
+	for _, output := range outputs {
+		if output.Sensitive {
+			sensitiveValues[output.Key()] = output.Value
+		} else {
+			values[output.Key()] = output.Value
+		}
+	}
+
+	d.SetId(environmentId)
+	d.Set("outputs", serializeEnvironmentOutputs(outputs))
+	d.Set("values", values)
+	d.Set("sensitive_values", sensitiveValues)
+
+	return nil
+}
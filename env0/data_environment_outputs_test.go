@@ -0,0 +1,111 @@
+package env0
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestEnvironmentOutputsDataSource(t *testing.T) {
+	environment := client.Environment{
+		Id:                    "environment-id",
+		LatestDeploymentLogId: "deployment-id",
+		LatestDeploymentLog: client.DeploymentLog{
+			Id: "deployment-id",
+			Output: []byte(`{
+				"vpc_id": {"value": "vpc-123", "type": "string", "sensitive": false},
+				"subnets": {"value": ["a", "b"], "type": ["list", "string"], "sensitive": false},
+				"password": {"value": "secret", "type": "string", "sensitive": true}
+			}`),
+		},
+	}
+
+	runAllDeployment := client.DeploymentLog{
+		Id:     "run-all-deployment-id",
+		Output: []byte(`[{"moduleName": "network", "outputs": {"vpc_id": {"value": "vpc-456", "type": "string", "sensitive": false}}}, {"moduleName": "db", "outputs": {"port": {"value": 5432, "type": "number", "sensitive": false}}}]`),
+	}
+
+	resourceType := "env0_environment_outputs"
+	resourceName := "test"
+	accessor := dataSourceAccessor(resourceType, resourceName)
+
+	t.Run("Latest deployment", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: dataSourceConfigCreate(resourceType, resourceName, map[string]any{"environment_id": environment.Id}),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(accessor, "id", environment.Id),
+							resource.TestCheckResourceAttr(accessor, "deployment_id", environment.LatestDeploymentLogId),
+							resource.TestCheckResourceAttr(accessor, "outputs.#", "3"),
+							resource.TestCheckResourceAttr(accessor, "outputs.0.name", "password"),
+							resource.TestCheckResourceAttr(accessor, "outputs.0.sensitive", "true"),
+							resource.TestCheckResourceAttr(accessor, "outputs.0.value", ""),
+							resource.TestCheckResourceAttr(accessor, "outputs.1.name", "subnets"),
+							resource.TestCheckResourceAttr(accessor, "outputs.1.type", `["list","string"]`),
+							resource.TestCheckResourceAttr(accessor, "outputs.1.value", `["a","b"]`),
+							resource.TestCheckResourceAttr(accessor, "outputs.2.name", "vpc_id"),
+							resource.TestCheckResourceAttr(accessor, "outputs.2.type", "string"),
+							resource.TestCheckResourceAttr(accessor, "outputs.2.value", "vpc-123"),
+							resource.TestCheckResourceAttr(accessor, "values.%", "2"),
+							resource.TestCheckResourceAttr(accessor, "values.vpc_id", "vpc-123"),
+							resource.TestCheckResourceAttr(accessor, "values.subnets", `["a","b"]`),
+							resource.TestCheckResourceAttr(accessor, "sensitive_values.%", "1"),
+							resource.TestCheckResourceAttr(accessor, "sensitive_values.password", "secret"),
+						),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().Environment(environment.Id).AnyTimes().Return(environment, nil)
+			},
+		)
+	})
+
+	t.Run("Terragrunt run-all deployment", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: dataSourceConfigCreate(resourceType, resourceName, map[string]any{"environment_id": environment.Id, "deployment_id": runAllDeployment.Id}),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(accessor, "deployment_id", runAllDeployment.Id),
+							resource.TestCheckResourceAttr(accessor, "outputs.#", "2"),
+							resource.TestCheckResourceAttr(accessor, "outputs.0.module", "db"),
+							resource.TestCheckResourceAttr(accessor, "outputs.0.key", "db.port"),
+							resource.TestCheckResourceAttr(accessor, "outputs.0.type", "number"),
+							resource.TestCheckResourceAttr(accessor, "outputs.1.module", "network"),
+							resource.TestCheckResourceAttr(accessor, "outputs.1.key", "network.vpc_id"),
+							resource.TestCheckResourceAttr(accessor, "values.db.port", "5432"),
+							resource.TestCheckResourceAttr(accessor, "values.network.vpc_id", "vpc-456"),
+							resource.TestCheckResourceAttr(accessor, "sensitive_values.%", "0"),
+						),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().EnvironmentDeploymentLog(runAllDeployment.Id).AnyTimes().Return(&runAllDeployment, nil)
+			},
+		)
+	})
+
+	t.Run("Error when getting the environment", func(t *testing.T) {
+		runUnitTest(t,
+			resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config:      dataSourceConfigCreate(resourceType, resourceName, map[string]any{"environment_id": environment.Id}),
+						ExpectError: regexp.MustCompile("could not read environment: error"),
+					},
+				},
+			},
+			func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().Environment(environment.Id).Return(client.Environment{}, errors.New("error"))
+			},
+		)
+	})
+}
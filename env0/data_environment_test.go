@@ -87,6 +87,10 @@ func TestEnvironmentDataSource(t *testing.T) {
 						resource.TestCheckResourceAttr(accessor, "template_id", environment.LatestDeploymentLog.BlueprintId),
 						resource.TestCheckResourceAttr(accessor, "revision", environment.LatestDeploymentLog.BlueprintRevision),
 						resource.TestCheckResourceAttr(accessor, "output", string(environment.LatestDeploymentLog.Output)),
+						resource.TestCheckResourceAttr(accessor, "outputs.#", "1"),
+						resource.TestCheckResourceAttr(accessor, "outputs.0.name", "a"),
+						resource.TestCheckResourceAttr(accessor, "outputs.0.type", "string"),
+						resource.TestCheckResourceAttr(accessor, "outputs.0.value", "b"),
 						resource.TestCheckResourceAttr(accessor, "token_id", template.TokenId),
 						resource.TestCheckResourceAttr(accessor, "github_installation_id", strconv.Itoa(template.GithubInstallationId)),
 						resource.TestCheckResourceAttr(accessor, "bitbucket_client_key", template.BitbucketClientKey),
@@ -60,7 +60,7 @@ func dataEnvironments() *schema.Resource {
 }
 
 func dataEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Get("project_id").(string)
 	organizationId := d.Get("organization_id").(string)
@@ -36,9 +36,9 @@ func dataGitTokenRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 
 	id, ok := d.GetOk("id")
 	if ok {
-		gitToken, err = meta.(client.ApiClientInterface).GitToken(id.(string))
+		gitToken, err = meta.(client.ApiClientInterface).WithContext(ctx).GitToken(id.(string))
 	} else {
-		gitToken, err = getGitTokenByName(d.Get("name").(string), meta)
+		gitToken, err = getGitTokenByName(ctx, d.Get("name").(string), meta)
 	}
 
 	if err != nil {
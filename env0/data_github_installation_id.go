@@ -31,7 +31,7 @@ func dataGithubInstallationId() *schema.Resource {
 }
 
 func dataGithubInstallationIdRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	repositroy := d.Get("repository").(string)
 
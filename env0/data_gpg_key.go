@@ -46,12 +46,12 @@ func dataGpgKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	id, ok := d.GetOk("id")
 	if ok {
-		gpgKey, err = getGpgKeyById(id.(string), meta)
+		gpgKey, err = getGpgKeyById(ctx, id.(string), meta)
 		if err != nil {
 			return diag.Errorf("could not read gpg key: %v", err)
 		}
 	} else {
-		gpgKey, err = getGpgKeyByName(d.Get("name").(string), meta)
+		gpgKey, err = getGpgKeyByName(ctx, d.Get("name").(string), meta)
 		if err != nil {
 			return diag.Errorf("could not read api key: %v", err)
 		}
@@ -38,9 +38,9 @@ func dataKubernetesCredentialsRead(credentialsType CloudType) func(ctx context.C
 
 		id, ok := d.GetOk("id")
 		if ok {
-			credentials, err = getCredentialsById(id.(string), credentialsTypeToPrefixList[credentialsType], meta)
+			credentials, err = getCredentialsById(ctx, id.(string), credentialsTypeToPrefixList[credentialsType], meta)
 		} else {
-			credentials, err = getCredentialsByName(d.Get("name").(string), credentialsTypeToPrefixList[credentialsType], meta)
+			credentials, err = getCredentialsByName(ctx, d.Get("name").(string), credentialsTypeToPrefixList[credentialsType], meta)
 		}
 
 		if err != nil {
@@ -76,10 +76,10 @@ func dataModuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	id, ok := d.GetOk("id")
 	if ok {
-		module, err = meta.(client.ApiClientInterface).Module(id.(string))
+		module, err = meta.(client.ApiClientInterface).WithContext(ctx).Module(id.(string))
 	} else {
 		name := d.Get("module_name").(string)
-		module, err = getModuleByName(name, meta)
+		module, err = getModuleByName(ctx, name, meta)
 	}
 
 	if err != nil {
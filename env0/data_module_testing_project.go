@@ -29,7 +29,7 @@ func dataModuleTestingProject() *schema.Resource {
 }
 
 func dataModuleTestingProjectRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(client.ApiClientInterface)
+	client := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	moduleTestingProject, err := client.ModuleTestingProject()
 	if err != nil {
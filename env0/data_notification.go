@@ -51,10 +51,10 @@ func dataNotificationRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	id, ok := d.GetOk("id")
 	if ok {
-		notification, err = getNotificationById(id.(string), meta)
+		notification, err = getNotificationById(ctx, id.(string), meta)
 	} else {
 		name := d.Get("name").(string)
-		notification, err = getNotificationByName(name, meta)
+		notification, err = getNotificationByName(ctx, name, meta)
 	}
 
 	if err != nil {
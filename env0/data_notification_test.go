@@ -62,6 +62,7 @@ func TestNotificationDataSource(t *testing.T) {
 	mockListNotificationsCall := func(returnValue []client.Notification) func(mockFunc *client.MockApiClientInterface) {
 		return func(mock *client.MockApiClientInterface) {
 			mock.EXPECT().Notifications().AnyTimes().Return(returnValue, nil)
+			mock.EXPECT().NotificationsIter().AnyTimes().Return(seqOf(returnValue...))
 		}
 	}
 
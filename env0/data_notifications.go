@@ -27,7 +27,7 @@ func dataNotifications() *schema.Resource {
 }
 
 func dataNotificationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	notifications, err := apiClient.Notifications()
 	if err != nil {
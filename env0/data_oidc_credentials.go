@@ -38,9 +38,9 @@ func dataOidcCredentialRead(credentialsType CloudType) func(ctx context.Context,
 
 		id, ok := d.GetOk("id")
 		if ok {
-			credentials, err = getCredentialsById(id.(string), credentialsTypeToPrefixList[credentialsType], meta)
+			credentials, err = getCredentialsById(ctx, id.(string), credentialsTypeToPrefixList[credentialsType], meta)
 		} else {
-			credentials, err = getCredentialsByName(d.Get("name").(string), credentialsTypeToPrefixList[credentialsType], meta)
+			credentials, err = getCredentialsByName(ctx, d.Get("name").(string), credentialsTypeToPrefixList[credentialsType], meta)
 		}
 
 		if err != nil {
@@ -13,6 +13,7 @@ func dataOrganization() *schema.Resource {
 		ReadContext: dataOrganizationRead,
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "the name of the organization",
@@ -43,7 +44,9 @@ func dataOrganization() *schema.Resource {
 }
 
 func dataOrganizationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organization, err := apiClient.Organization()
 	if err != nil {
@@ -16,6 +16,7 @@ func dataProject() *schema.Resource {
 		ReadContext: dataProjectRead,
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"name": {
 				Type:         schema.TypeString,
 				Description:  "the name of the project",
@@ -81,13 +82,15 @@ func dataProject() *schema.Resource {
 }
 
 func dataProjectRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	var err error
 
 	var project client.Project
 
 	id, ok := d.GetOk("id")
 	if ok {
-		project, err = getProjectById(id.(string), meta)
+		project, err = getProjectById(ctx, id.(string), meta)
 		if err != nil {
 			return diag.Errorf("%v", err)
 		}
@@ -97,7 +100,7 @@ func dataProjectRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 			return diag.Errorf("either 'name' or 'id' must be specified")
 		}
 
-		project, err = getProjectByName(name.(string), d.Get("parent_project_id").(string), d.Get("parent_project_name").(string), d.Get("parent_project_path").(string), meta)
+		project, err = getProjectByName(ctx, name.(string), d.Get("parent_project_id").(string), d.Get("parent_project_name").(string), d.Get("parent_project_path").(string), meta)
 		if err != nil {
 			return diag.Errorf("%v", err)
 		}
@@ -126,7 +129,7 @@ func filterByParentProjectId(parentId string, projects []client.Project) []clien
 	return filteredProjects
 }
 
-func filterByParentProjectName(parentName string, projects []client.Project, meta any) ([]client.Project, error) {
+func filterByParentProjectName(ctx context.Context, parentName string, projects []client.Project, meta any) ([]client.Project, error) {
 	filteredProjects := make([]client.Project, 0)
 
 	for _, project := range projects {
@@ -134,7 +137,7 @@ func filterByParentProjectName(parentName string, projects []client.Project, met
 			continue
 		}
 
-		parentProject, err := getProjectById(project.ParentProjectId, meta)
+		parentProject, err := getProjectById(ctx, project.ParentProjectId, meta)
 		if err != nil {
 			return nil, err
 		}
@@ -147,8 +150,8 @@ func filterByParentProjectName(parentName string, projects []client.Project, met
 	return filteredProjects, nil
 }
 
-func getProjectByName(name string, parentId string, parentName string, parentPath string, meta any) (client.Project, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getProjectByName(ctx context.Context, name string, parentId string, parentName string, parentPath string, meta any) (client.Project, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projects, err := apiClient.Projects()
 	if err != nil {
@@ -169,12 +172,12 @@ func getProjectByName(name string, parentId string, parentName string, parentPat
 	case len(parentId) > 0:
 		projectsByName = filterByParentProjectId(parentId, projectsByName)
 	case len(parentName) > 0:
-		projectsByName, err = filterByParentProjectName(parentName, projectsByName, meta)
+		projectsByName, err = filterByParentProjectName(ctx, parentName, projectsByName, meta)
 		if err != nil {
 			return client.Project{}, err
 		}
 	case len(parentPath) > 0:
-		projectsByName, err = filterByParentProjectPath(parentPath, projectsByName, meta)
+		projectsByName, err = filterByParentProjectPath(ctx, parentPath, projectsByName, meta)
 		if err != nil {
 			return client.Project{}, err
 		}
@@ -191,12 +194,12 @@ func getProjectByName(name string, parentId string, parentName string, parentPat
 	return projectsByName[0], nil
 }
 
-func pathMatches(path, parentIds []string, meta any) (bool, error) {
+func pathMatches(ctx context.Context, path, parentIds []string, meta any) (bool, error) {
 	if len(path) > len(parentIds) {
 		return false, nil
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	for i := range path {
 		parentId := parentIds[i]
@@ -214,7 +217,7 @@ func pathMatches(path, parentIds []string, meta any) (bool, error) {
 	return true, nil
 }
 
-func filterByParentProjectPath(parentPath string, projectsByName []client.Project, meta any) ([]client.Project, error) {
+func filterByParentProjectPath(ctx context.Context, parentPath string, projectsByName []client.Project, meta any) ([]client.Project, error) {
 	filteredProjects := make([]client.Project, 0)
 
 	path := strings.Split(parentPath, "|")
@@ -224,7 +227,7 @@ func filterByParentProjectPath(parentPath string, projectsByName []client.Projec
 		// right most element is the project itself, remove it.
 		parentIds = parentIds[:len(parentIds)-1]
 
-		matches, err := pathMatches(path, parentIds, meta)
+		matches, err := pathMatches(ctx, path, parentIds, meta)
 		if err != nil {
 			return nil, err
 		}
@@ -237,8 +240,8 @@ func filterByParentProjectPath(parentPath string, projectsByName []client.Projec
 	return filteredProjects, nil
 }
 
-func getProjectById(id string, meta any) (client.Project, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getProjectById(ctx context.Context, id string, meta any) (client.Project, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	project, err := apiClient.Project(id)
 	if err != nil {
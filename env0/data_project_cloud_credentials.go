@@ -33,7 +33,7 @@ func dataProjectCloudCredentials() *schema.Resource {
 }
 
 func dataProjectCloudCredentialsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Get("project_id").(string)
 
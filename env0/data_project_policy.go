@@ -104,7 +104,7 @@ func dataPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	projectId, ok := d.GetOk("project_id")
 	if ok {
-		policy, err = getPolicyByProjectId(projectId.(string), meta)
+		policy, err = getPolicyByProjectId(ctx, projectId.(string), meta)
 		if err != nil {
 			return err
 		}
@@ -119,8 +119,8 @@ func dataPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	return nil
 }
 
-func getPolicyByProjectId(projectId string, meta any) (client.Policy, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getPolicyByProjectId(ctx context.Context, projectId string, meta any) (client.Policy, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	policy, err := apiClient.Policy(projectId)
 	if err != nil {
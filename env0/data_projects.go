@@ -13,6 +13,7 @@ func dataProjects() *schema.Resource {
 		ReadContext: dataProjectsRead,
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"include_archived_projects": {
 				Type:        schema.TypeBool,
 				Description: "set to 'true' to include archived projects (defaults to 'false')",
@@ -66,7 +67,9 @@ func dataProjects() *schema.Resource {
 }
 
 func dataProjectsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	includeArchivedProjects := d.Get("include_archived_projects").(bool)
 
@@ -41,10 +41,10 @@ func dataProviderRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 
 	id, ok := d.GetOk("id")
 	if ok {
-		provider, err = meta.(client.ApiClientInterface).Provider(id.(string))
+		provider, err = meta.(client.ApiClientInterface).WithContext(ctx).Provider(id.(string))
 	} else {
 		name := d.Get("type").(string)
-		provider, err = getProviderByName(name, meta)
+		provider, err = getProviderByName(ctx, name, meta)
 	}
 
 	if err != nil {
@@ -53,7 +53,7 @@ func dataSourceCodeVariables() *schema.Resource {
 }
 
 func dataSourceCodeVariablesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	templateId := d.Get("template_id").(string)
 
@@ -40,14 +40,14 @@ func dataSshKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	var err error
 
 	if name, ok := d.GetOk("name"); ok {
-		sshKey, err = getSshKeyByName(name, meta)
+		sshKey, err = getSshKeyByName(ctx, name, meta)
 		if err != nil {
 			return diag.Errorf("could not read ssh key: %v", err)
 		}
 	} else {
 		id := d.Get("id")
 
-		sshKey, err = getSshKeyById(id, meta)
+		sshKey, err = getSshKeyById(ctx, id.(string), meta)
 		if err != nil {
 			return diag.Errorf("could not read ssh key: %v", err)
 		}
@@ -64,8 +64,8 @@ func dataSshKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	return nil
 }
 
-func getSshKeyByName(name any, meta any) (*client.SshKey, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getSshKeyByName(ctx context.Context, name any, meta any) (*client.SshKey, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	return backoff.RetryWithData(func() (*client.SshKey, error) {
 		sshKeys, err := apiClient.SshKeys()
@@ -93,21 +93,13 @@ func getSshKeyByName(name any, meta any) (*client.SshKey, error) {
 	}, backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(time.Minute*1), backoff.WithMaxInterval(time.Second*10)))
 }
 
-func getSshKeyById(id any, meta any) (*client.SshKey, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getSshKeyById(ctx context.Context, id string, meta any) (*client.SshKey, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
-	sshKeys, err := apiClient.SshKeys()
-	if err != nil {
+	sshKey, found, err := client.FindFirst(apiClient.SshKeysIter(), func(candidate client.SshKey) bool { return candidate.Id == id })
+	if err != nil || !found {
 		return nil, err
 	}
 
-	var sshKey *client.SshKey
-
-	for _, candidate := range sshKeys {
-		if candidate.Id == id.(string) {
-			sshKey = &candidate
-		}
-	}
-
-	return sshKey, nil
+	return &sshKey, nil
 }
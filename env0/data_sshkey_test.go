@@ -35,7 +35,7 @@ func TestUnitSshKeyDataSourceByIdNotFound(t *testing.T) {
 	}
 
 	runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
-		mock.EXPECT().SshKeys().Times(1).Return(nil, nil)
+		mock.EXPECT().SshKeysIter().Times(1).Return(seqOf[client.SshKey]())
 	})
 }
 
@@ -72,6 +72,7 @@ func testUnitSshKeyDataSource(t *testing.T, byKey string) {
 	runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
 		// TODO: AnyTimes because we find that READ runs for 5 times. need investigation.
 		mock.EXPECT().SshKeys().AnyTimes().Return([]client.SshKey{sshKey}, nil)
+		mock.EXPECT().SshKeysIter().AnyTimes().Return(seqOf(sshKey))
 	})
 }
 
@@ -13,6 +13,7 @@ func dataTeam() *schema.Resource {
 		ReadContext: dataTeamRead,
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"name": {
 				Type:         schema.TypeString,
 				Description:  "The name of the team",
@@ -35,13 +36,15 @@ func dataTeam() *schema.Resource {
 }
 
 func dataTeamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	var err diag.Diagnostics
 
 	var team client.Team
 
 	id, ok := d.GetOk("id")
 	if ok {
-		team, err = getTeamById(id.(string), meta)
+		team, err = getTeamById(ctx, id.(string), meta)
 		if err != nil {
 			return err
 		}
@@ -51,7 +54,7 @@ func dataTeamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 			return diag.Errorf("Either 'name' or 'id' must be specified")
 		}
 
-		team, err = getTeamByName(name.(string), meta)
+		team, err = getTeamByName(ctx, name.(string), meta)
 		if err != nil {
 			return err
 		}
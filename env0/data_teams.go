@@ -15,6 +15,7 @@ func dataTeams() *schema.Resource {
 		Description: "Note: this data source is cached, once fetched it will not be updated until the next plan/apply",
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"names": {
 				Type:        schema.TypeList,
 				Description: "list of all teams (by name)",
@@ -35,7 +36,9 @@ func dataTeams() *schema.Resource {
 }
 
 func dataTeamsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var regex *regexp.Regexp
 
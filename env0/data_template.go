@@ -13,6 +13,7 @@ func dataTemplate() *schema.Resource {
 		ReadContext: dataTemplateRead,
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"name": {
 				Type:         schema.TypeString,
 				Description:  "the name of the template",
@@ -158,15 +159,17 @@ func dataTemplate() *schema.Resource {
 }
 
 func dataTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	var template client.Template
 
 	var err diag.Diagnostics
 
 	if name, ok := d.GetOk("name"); ok {
-		if template, err = getTemplateByName(name, meta); err != nil {
+		if template, err = getTemplateByName(ctx, name, meta); err != nil {
 			return err
 		}
-	} else if template, err = getTemplateById(d.Get("id").(string), meta); err != nil {
+	} else if template, err = getTemplateById(ctx, d.Get("id").(string), meta); err != nil {
 		return diag.Errorf("Could not query template: %v", err)
 	}
 
@@ -180,8 +183,8 @@ func dataTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	return nil
 }
 
-func getTemplateByName(name any, meta any) (client.Template, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getTemplateByName(ctx context.Context, name any, meta any) (client.Template, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	templates, err := apiClient.TemplatesByName(name.(string))
 	if err != nil {
@@ -207,8 +210,8 @@ func getTemplateByName(name any, meta any) (client.Template, diag.Diagnostics) {
 	return templatesByName[0], nil
 }
 
-func getTemplateById(id any, meta any) (client.Template, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getTemplateById(ctx context.Context, id any, meta any) (client.Template, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	template, err := apiClient.Template(id.(string))
 	if err != nil {
@@ -13,6 +13,7 @@ func dataTemplates() *schema.Resource {
 		ReadContext: dataTemplatesRead,
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": organizationIdSchema(),
 			"names": {
 				Type:        schema.TypeList,
 				Description: "list of all templates (by name)",
@@ -27,7 +28,9 @@ func dataTemplates() *schema.Resource {
 }
 
 func dataTemplatesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	templates, err := apiClient.Templates()
 	if err != nil {
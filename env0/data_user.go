@@ -27,8 +27,8 @@ func dataUser() *schema.Resource {
 	}
 }
 
-func getUserByEmail(email string, meta any) (*client.User, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getUserByEmail(ctx context.Context, email string, meta any) (*client.User, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organizationUsers, err := apiClient.Users()
 	if err != nil {
@@ -57,7 +57,7 @@ func getUserByEmail(email string, meta any) (*client.User, diag.Diagnostics) {
 func dataUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	email := d.Get("email").(string)
 
-	user, err := getUserByEmail(email, meta)
+	user, err := getUserByEmail(ctx, email, meta)
 	if err != nil {
 		return err
 	}
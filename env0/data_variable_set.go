@@ -49,7 +49,7 @@ func dataVariableSetRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var scopeId string
 
@@ -56,7 +56,7 @@ func dataVcsConnection() *schema.Resource {
 }
 
 func dataVcsConnectionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	accessScope := d.Get("access_scope").(string)
 	connectionType := d.Get("connection_type").(string)
@@ -36,7 +36,7 @@ func dataWorkflowTriggers() *schema.Resource {
 }
 
 func dataWorkflowTriggersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 
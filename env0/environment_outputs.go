@@ -0,0 +1,270 @@
+package env0
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// environmentOutput is an output of a deployment.
+type environmentOutput struct {
+	Name string
+	// Module is the module of the output in a Terragrunt run-all deployment (empty otherwise).
+	Module string
+	// Value is the value of the output. Strings are returned as is, other values are json encoded.
+	Value     string
+	Type      string
+	Sensitive bool
+}
+
+// Key returns the key of the output in the outputs maps: "<module>.<name>" in a Terragrunt run-all deployment, "<name>" otherwise.
+func (o environmentOutput) Key() string {
+	if o.Module == "" {
+		return o.Name
+	}
+
+	return o.Module + "." + o.Name
+}
+
+// terragruntModuleOutputs are the outputs of a module in a Terragrunt run-all deployment.
+type terragruntModuleOutputs struct {
+	ModuleName string                     `json:"moduleName"`
+	Outputs    map[string]json.RawMessage `json:"outputs"`
+	Output     map[string]json.RawMessage `json:"output"`
+}
+
+// parseEnvironmentOutputs parses the output of a deployment. The output is either a map of outputs (the "terraform output -json"
+// format, or a map of key-value), or an array (in case of Terragrunt run-all) of moduleName and its map of outputs.
+// The outputs are sorted by module and name. A deployment that is still in progress has no outputs.
+func parseEnvironmentOutputs(output json.RawMessage) ([]environmentOutput, error) {
+	output = bytes.TrimSpace(output)
+
+	if len(output) == 0 || bytes.Equal(output, []byte("null")) {
+		return []environmentOutput{}, nil
+	}
+
+	outputs := []environmentOutput{}
+
+	if output[0] == '[' {
+		var modules []terragruntModuleOutputs
+		if err := json.Unmarshal(output, &modules); err != nil {
+			return nil, fmt.Errorf("failed to parse the Terragrunt run-all deployment output: %w", err)
+		}
+
+		for _, module := range modules {
+			moduleOutputs := module.Outputs
+			if moduleOutputs == nil {
+				moduleOutputs = module.Output
+			}
+
+			parsed, err := parseOutputsMap(module.ModuleName, moduleOutputs)
+			if err != nil {
+				return nil, err
+			}
+
+			outputs = append(outputs, parsed...)
+		}
+	} else {
+		var outputsMap map[string]json.RawMessage
+		if err := json.Unmarshal(output, &outputsMap); err != nil {
+			return nil, fmt.Errorf("failed to parse the deployment output: %w", err)
+		}
+
+		parsed, err := parseOutputsMap("", outputsMap)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = parsed
+	}
+
+	slices.SortFunc(outputs, func(a, b environmentOutput) int {
+		return cmp.Or(cmp.Compare(a.Module, b.Module), cmp.Compare(a.Name, b.Name))
+	})
+
+	return outputs, nil
+}
+
+func parseOutputsMap(module string, outputsMap map[string]json.RawMessage) ([]environmentOutput, error) {
+	outputs := make([]environmentOutput, 0, len(outputsMap))
+
+	for name, raw := range outputsMap {
+		output, err := parseOutput(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the deployment output '%s': %w", name, err)
+		}
+
+		output.Name = name
+		output.Module = module
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+// parseOutput parses an output of the "terraform output -json" format ({"value": ..., "type": ..., "sensitive": ...}).
+// Any other value is a plain value, and its type is inferred from the value.
+func parseOutput(raw json.RawMessage) (environmentOutput, error) {
+	var typed struct {
+		Value     json.RawMessage `json:"value"`
+		Type      json.RawMessage `json:"type"`
+		Sensitive bool            `json:"sensitive"`
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+		// A map that isn't of the "terraform output -json" format is a plain value.
+		if err := json.Unmarshal(raw, &typed); err != nil {
+			typed.Value = nil
+		}
+	}
+
+	if typed.Value == nil {
+		return environmentOutput{Value: outputValue(raw), Type: inferOutputType(raw)}, nil
+	}
+
+	outputType := inferOutputType(typed.Value)
+
+	if len(typed.Type) > 0 {
+		// The type is either a primitive type ("string") or a complex type (["list","string"]), which is returned json encoded.
+		var primitiveType string
+		if err := json.Unmarshal(typed.Type, &primitiveType); err == nil {
+			outputType = primitiveType
+		} else {
+			var compactType bytes.Buffer
+			if err := json.Compact(&compactType, typed.Type); err != nil {
+				return environmentOutput{}, err
+			}
+
+			outputType = compactType.String()
+		}
+	}
+
+	return environmentOutput{Value: outputValue(typed.Value), Type: outputType, Sensitive: typed.Sensitive}, nil
+}
+
+func outputValue(raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+
+	var compactValue bytes.Buffer
+	if err := json.Compact(&compactValue, raw); err != nil {
+		return string(raw)
+	}
+
+	return compactValue.String()
+}
+
+func inferOutputType(raw json.RawMessage) string {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return ""
+	}
+
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "map"
+	default:
+		return "null"
+	}
+}
+
+// environmentOutputsSchema is the schema of the parsed outputs of a deployment.
+func environmentOutputsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "the name of the output",
+					Computed:    true,
+				},
+				"module": {
+					Type:        schema.TypeString,
+					Description: "the module of the output in a Terragrunt run-all deployment (empty otherwise)",
+					Computed:    true,
+				},
+				"key": {
+					Type:        schema.TypeString,
+					Description: "the key of the output in the values maps: 'module_name.output_name' in a Terragrunt run-all deployment, 'output_name' otherwise",
+					Computed:    true,
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Description: "the type of the output (E.g. string, number, bool). Complex types are json encoded (E.g. [\"list\",\"string\"])",
+					Computed:    true,
+				},
+				"sensitive": {
+					Type:        schema.TypeBool,
+					Description: "true if the output is sensitive",
+					Computed:    true,
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Description: "the value of the output. Strings are returned as is, other values are json encoded (use jsondecode). Empty for sensitive outputs",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// serializeEnvironmentOutputs returns the outputs in the format of environmentOutputsSchema. The values of sensitive outputs are omitted.
+func serializeEnvironmentOutputs(outputs []environmentOutput) []any {
+	serialized := make([]any, 0, len(outputs))
+
+	for _, output := range outputs {
+		value := output.Value
+		if output.Sensitive {
+			value = ""
+		}
+
+		serialized = append(serialized, map[string]any{
+			"name":      output.Name,
+			"module":    output.Module,
+			"key":       output.Key(),
+			"type":      output.Type,
+			"sensitive": output.Sensitive,
+			"value":     value,
+		})
+	}
+
+	return serialized
+}
+
+// setDeploymentOutput sets "output" to the raw output of the deployment, and "outputs" to the parsed outputs.
+// An output that can't be parsed is only logged ("output" is still set), so it doesn't fail the read.
+func setDeploymentOutput(ctx context.Context, d *schema.ResourceData, output json.RawMessage) {
+	if len(output) == 0 {
+		d.Set("output", "null")
+	} else {
+		d.Set("output", string(output))
+	}
+
+	outputs, err := parseEnvironmentOutputs(output)
+	if err != nil {
+		tflog.Warn(ctx, "could not parse the deployment output", map[string]any{"error": err.Error()})
+
+		outputs = []environmentOutput{}
+	}
+
+	d.Set("outputs", serializeEnvironmentOutputs(outputs))
+}
@@ -0,0 +1,59 @@
+package env0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvironmentOutputs(t *testing.T) {
+	for _, testCase := range []struct {
+		name     string
+		output   string
+		expected []environmentOutput
+	}{
+		{"in progress", "", []environmentOutput{}},
+		{"null", "null", []environmentOutput{}},
+		{
+			"key-value",
+			`{"b": "value", "a": {"x": 1}}`,
+			[]environmentOutput{
+				{Name: "a", Value: `{"x":1}`, Type: "map"},
+				{Name: "b", Value: "value", Type: "string"},
+			},
+		},
+		{
+			"terraform output",
+			`{"password": {"value": "secret", "type": "string", "sensitive": true}, "ids": {"value": [1, 2], "type": ["list", "number"]}}`,
+			[]environmentOutput{
+				{Name: "ids", Value: "[1,2]", Type: `["list","number"]`},
+				{Name: "password", Value: "secret", Type: "string", Sensitive: true},
+			},
+		},
+		{
+			"terragrunt run-all",
+			`[{"moduleName": "vpc", "outputs": {"id": {"value": "vpc-1", "type": "string"}}}, {"moduleName": "db", "output": {"enabled": true}}]`,
+			[]environmentOutput{
+				{Name: "enabled", Module: "db", Value: "true", Type: "bool"},
+				{Name: "id", Module: "vpc", Value: "vpc-1", Type: "string"},
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			outputs, err := parseEnvironmentOutputs([]byte(testCase.output))
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, outputs)
+		})
+	}
+
+	t.Run("key", func(t *testing.T) {
+		assert.Equal(t, "id", environmentOutput{Name: "id"}.Key())
+		assert.Equal(t, "vpc.id", environmentOutput{Name: "id", Module: "vpc"}.Key())
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		_, err := parseEnvironmentOutputs([]byte(`"output"`))
+		require.ErrorContains(t, err, "failed to parse the deployment output")
+	})
+}
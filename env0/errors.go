@@ -3,9 +3,12 @@ package env0
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/env0/terraform-provider-env0/client"
 	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,13 +36,63 @@ func ResourceGetFailure(ctx context.Context, resourceName string, d *schema.Reso
 		return nil
 	}
 
-	return diag.Errorf("could not get %s: %v", resourceName, err)
+	return ApiFailure("could not get "+resourceName, d, err)
 }
 
 func DataGetFailure(dataName string, id any, err error) diag.Diagnostics {
-	if frerr, ok := err.(*http.FailedResponseError); ok && frerr.NotFound() {
+	var failedResponseError *http.FailedResponseError
+	if errors.As(err, &failedResponseError) && failedResponseError.NotFound() {
 		return diag.Errorf("could not read %s: id %v not found", dataName, id)
 	}
 
-	return diag.Errorf("could not read %s: %v", dataName, err)
+	return ApiFailure("could not read "+dataName, nil, err)
+}
+
+// ApiFailure returns an error diagnostic for a failed API call (e.g. summary is "could not create project").
+// When the API responded with an error, the diagnostic includes the status code, the error code and the request id
+// (required by env0 support), and points to the failing attribute if it can be inferred from the response.
+// d may be nil.
+func ApiFailure(summary string, d *schema.ResourceData, err error) diag.Diagnostics {
+	var failedResponseError *http.FailedResponseError
+	if !errors.As(err, &failedResponseError) {
+		return diag.Errorf("%s: %v", summary, err)
+	}
+
+	message := failedResponseError.Message
+	if message == "" {
+		message = failedResponseError.Error()
+	}
+
+	details := []string{fmt.Sprintf("status code: %d", failedResponseError.StatusCode)}
+	if failedResponseError.ErrorCode != "" {
+		details = append(details, "error code: "+failedResponseError.ErrorCode)
+	}
+
+	if failedResponseError.RequestId != "" {
+		details = append(details, fmt.Sprintf("request id: %s (include it when contacting env0 support)", failedResponseError.RequestId))
+	}
+
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("%s: %s", summary, message),
+		Detail:        strings.Join(details, "\n"),
+		AttributePath: failedAttributePath(d, failedResponseError.Field),
+	}}
+}
+
+// failedAttributePath maps a field of the request body (e.g. "projectId") to a root attribute of the resource (e.g. "project_id").
+// Returns nil if the resource has no such attribute.
+func failedAttributePath(d *schema.ResourceData, field string) cty.Path {
+	if d == nil || field == "" {
+		return nil
+	}
+
+	name := toSnakeCase(strings.Split(field, ".")[0])
+
+	configType := d.GetRawConfig().Type()
+	if !configType.IsObjectType() || !configType.HasAttribute(name) {
+		return nil
+	}
+
+	return cty.GetAttrPath(name)
 }
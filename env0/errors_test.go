@@ -0,0 +1,91 @@
+package env0
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiFailure(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{"name": "my-project"})
+
+	t.Run("non api error", func(t *testing.T) {
+		diags := ApiFailure("could not create project", d, errors.New("error"))
+
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Error, diags[0].Severity)
+		assert.Equal(t, "could not create project: error", diags[0].Summary)
+		assert.Empty(t, diags[0].Detail)
+		assert.Nil(t, diags[0].AttributePath)
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		err := &http.FailedResponseError{
+			StatusCode: 409,
+			Message:    "project already exists",
+			ErrorCode:  "PROJECT_EXISTS",
+			RequestId:  "req-123",
+		}
+
+		diags := ApiFailure("could not create project", d, err)
+
+		require.Len(t, diags, 1)
+		assert.Equal(t, "could not create project: project already exists", diags[0].Summary)
+		assert.Equal(t, "status code: 409\nerror code: PROJECT_EXISTS\nrequest id: req-123 (include it when contacting env0 support)", diags[0].Detail)
+		assert.Nil(t, diags[0].AttributePath)
+	})
+
+	t.Run("api error with a known field", func(t *testing.T) {
+		err := &http.FailedResponseError{
+			StatusCode: 400,
+			Message:    "parentProjectId must be a UUID",
+			Field:      "parentProjectId",
+		}
+
+		diags := ApiFailure("could not create project", d, err)
+
+		require.Len(t, diags, 1)
+		assert.Equal(t, "status code: 400", diags[0].Detail)
+		assert.Equal(t, cty.GetAttrPath("parent_project_id"), diags[0].AttributePath)
+	})
+
+	t.Run("api error with an unknown field", func(t *testing.T) {
+		err := &http.FailedResponseError{
+			StatusCode: 400,
+			Message:    "templateId must be a UUID",
+			Field:      "templateId",
+		}
+
+		diags := ApiFailure("could not create project", d, err)
+
+		require.Len(t, diags, 1)
+		assert.Nil(t, diags[0].AttributePath)
+	})
+
+	t.Run("wrapped api error without resource data", func(t *testing.T) {
+		err := &http.FailedResponseError{
+			StatusCode: 400,
+			Message:    "name must be a string",
+			Field:      "name",
+		}
+
+		diags := ApiFailure("could not read project", nil, errors.Join(errors.New("failed"), err))
+
+		require.Len(t, diags, 1)
+		assert.Equal(t, "could not read project: name must be a string", diags[0].Summary)
+		assert.Nil(t, diags[0].AttributePath)
+	})
+}
+
+func TestDataGetFailure(t *testing.T) {
+	diags := DataGetFailure("project", "id0", errors.Join(errors.New("failed"), http.NewMockFailedResponseError(404)))
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, "could not read project: id id0 not found", diags[0].Summary)
+}
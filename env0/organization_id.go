@@ -0,0 +1,29 @@
+package env0
+
+import (
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func organizationIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "the id of the organization. Overrides the organization of the provider (for API keys that are assigned to multiple organizations). The API key must be assigned to the organization",
+		Optional:    true,
+		Computed:    true,
+	}
+}
+
+func resourceOrganizationIdSchema() *schema.Schema {
+	s := organizationIdSchema()
+	s.ForceNew = true
+
+	return s
+}
+
+// withOrganizationId returns the api client of the organization set in 'organization_id' (if set).
+func withOrganizationId(d *schema.ResourceData, meta any) client.ApiClientInterface {
+	organizationId, _ := d.Get("organization_id").(string)
+
+	return meta.(client.ApiClientInterface).WithOrganizationId(organizationId)
+}
@@ -0,0 +1,157 @@
+package env0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrganizationIdOverride(t *testing.T) {
+	team := client.Team{
+		Id:             "team-id",
+		Name:           "team",
+		OrganizationId: "other-organization",
+	}
+
+	t.Run("organization_id is set", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providerClient := client.NewMockApiClientInterface(ctrl)
+		organizationClient := client.NewMockApiClientInterface(ctrl)
+
+		providerClient.EXPECT().WithOrganizationId("other-organization").Return(organizationClient).Times(2)
+		organizationClient.EXPECT().WithContext(gomock.Any()).Return(organizationClient).Times(2)
+		organizationClient.EXPECT().TeamCreate(client.TeamCreatePayload{Name: "team"}).Return(team, nil)
+		organizationClient.EXPECT().Team("team-id").Return(team, nil)
+
+		d := schema.TestResourceDataRaw(t, resourceTeam().Schema, map[string]any{
+			"name":            "team",
+			"organization_id": "other-organization",
+		})
+
+		require.Empty(t, resourceTeamCreate(context.Background(), d, providerClient))
+		require.Empty(t, resourceTeamRead(context.Background(), d, providerClient))
+		assert.Equal(t, "team-id", d.Id())
+		assert.Equal(t, "other-organization", d.Get("organization_id"))
+	})
+
+	t.Run("organization_id is not set", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providerClient := client.NewMockApiClientInterface(ctrl)
+
+		providerClient.EXPECT().WithOrganizationId("").Return(providerClient)
+		providerClient.EXPECT().WithContext(gomock.Any()).Return(providerClient)
+		providerClient.EXPECT().Team("team-id").Return(team, nil)
+
+		d := schema.TestResourceDataRaw(t, resourceTeam().Schema, map[string]any{"name": "team"})
+		d.SetId("team-id")
+
+		require.Empty(t, resourceTeamRead(context.Background(), d, providerClient))
+		assert.Equal(t, "other-organization", d.Get("organization_id"))
+	})
+
+	t.Run("ssh key is created in the organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providerClient := client.NewMockApiClientInterface(ctrl)
+		organizationClient := client.NewMockApiClientInterface(ctrl)
+
+		providerClient.EXPECT().WithOrganizationId("other-organization").Return(organizationClient)
+		organizationClient.EXPECT().WithContext(gomock.Any()).Return(organizationClient)
+		organizationClient.EXPECT().SshKeyCreate(client.SshKeyCreatePayload{Name: "key", OrganizationId: "other-organization", Value: "value"}).Return(&client.SshKey{Id: "key-id"}, nil)
+
+		d := schema.TestResourceDataRaw(t, resourceSshKey().Schema, map[string]any{
+			"name":            "key",
+			"value":           "value",
+			"organization_id": "other-organization",
+		})
+
+		require.Empty(t, resourceSshKeyCreate(context.Background(), d, providerClient))
+		assert.Equal(t, "key-id", d.Id())
+	})
+}
+
+func TestOrganizationIdIsRead(t *testing.T) {
+	const (
+		id             = "id0"
+		organizationId = "organization0"
+	)
+
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		config   map[string]any
+		mock     func(mock *client.MockApiClientInterface)
+	}{
+		{
+			name:     "aws credentials",
+			resource: resourceAwsCredentials(),
+			config:   map[string]any{"name": "name0"},
+			mock: func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().CloudCredentials(id).Return(client.Credentials{Id: id, Name: "name0", OrganizationId: organizationId}, nil)
+			},
+		},
+		{
+			name:     "cost credentials",
+			resource: resourceCostCredentials(AWS),
+			config:   map[string]any{"name": "name0"},
+			mock: func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().CloudCredentials(id).Return(client.Credentials{Id: id, Name: "name0", OrganizationId: organizationId}, nil)
+			},
+		},
+		{
+			name:     "git token",
+			resource: resourceGitToken(),
+			config:   map[string]any{"name": "name0"},
+			mock: func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().GitToken(id).Return(&client.GitToken{Id: id, Name: "name0", OrganizationId: organizationId}, nil)
+			},
+		},
+		{
+			name:     "ssh key",
+			resource: resourceSshKey(),
+			config:   map[string]any{"name": "name0"},
+			mock: func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().SshKeysIter().Return(seqOf(client.SshKey{Id: id, Name: "name0", OrganizationId: organizationId}))
+			},
+		},
+		{
+			name:     "api key",
+			resource: resourceApiKey(),
+			config:   map[string]any{"name": "name0"},
+			mock: func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().ApiKeysIter().Return(seqOf(client.ApiKey{Id: id, Name: "name0", OrganizationId: organizationId}))
+			},
+		},
+		{
+			name:     "notification",
+			resource: resourceNotification(),
+			config:   map[string]any{"name": "name0"},
+			mock: func(mock *client.MockApiClientInterface) {
+				mock.EXPECT().NotificationsIter().Return(seqOf(client.Notification{Id: id, Name: "name0", OrganizationId: organizationId}))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			providerClient := client.NewMockApiClientInterface(ctrl)
+
+			providerClient.EXPECT().WithOrganizationId("").Return(providerClient).AnyTimes()
+			providerClient.EXPECT().WithContext(gomock.Any()).Return(providerClient).AnyTimes()
+			testCase.mock(providerClient)
+
+			// E.g. after an import, organization_id isn't set.
+			d := schema.TestResourceDataRaw(t, testCase.resource.Schema, testCase.config)
+			d.SetId(id)
+
+			require.Empty(t, testCase.resource.ReadContext(context.Background(), d, providerClient))
+			assert.Equal(t, id, d.Id())
+			assert.Equal(t, organizationId, d.Get("organization_id"))
+		})
+	}
+}
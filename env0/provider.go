@@ -2,6 +2,8 @@ package env0
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"os"
 	"time"
 
@@ -33,8 +35,8 @@ func Provider(version string) plugin.ProviderFunc {
 			Schema: map[string]*schema.Schema{
 				"api_endpoint": {
 					Type:        schema.TypeString,
-					Description: "env0 API endpoint. This can also be set via the ENV0_API_ENDPOINT environment variable, and is usually used for testing purposes.",
-					DefaultFunc: schema.EnvDefaultFunc("ENV0_API_ENDPOINT", "https://api.env0.com/"),
+					Description: "env0 API endpoint. This can also be set via the ENV0_API_ENDPOINT environment variable, and is usually used for testing purposes. Defaults to " + defaultApiEndpoint,
+					DefaultFunc: schema.EnvDefaultFunc("ENV0_API_ENDPOINT", nil),
 					Optional:    true,
 				},
 				"api_key": {
@@ -57,6 +59,45 @@ func Provider(version string) plugin.ProviderFunc {
 					DefaultFunc: schema.EnvDefaultFunc(apiOrganizationIdEnv, nil),
 					Optional:    true,
 				},
+				"profile": {
+					Type:        schema.TypeString,
+					Description: "the name of a profile in the shared credentials file (~/.env0/credentials, or the file set via the " + sharedCredentialsFileEnv + " environment variable) to read the api_key, api_secret, api_endpoint and organization_id from. Provider arguments and environment variables take precedence over the profile. This can also be set via the " + profileEnv + " environment variable. Defaults to the 'default' profile, if it exists and the api_key or api_secret aren't set by arguments or environment variables",
+					DefaultFunc: schema.EnvDefaultFunc(profileEnv, nil),
+					Optional:    true,
+				},
+				"skip_credentials_validation": {
+					Type:        schema.TypeBool,
+					Description: "skip the validation of the credentials when the provider is configured. The credentials are validated (and the organization is resolved) on the first API call instead, so commands that don't call the env0 API (E.g. a plan of a configuration without env0 resources) succeed without credentials. This can also be set via the ENV0_SKIP_CREDENTIALS_VALIDATION environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("ENV0_SKIP_CREDENTIALS_VALIDATION", false),
+					Optional:    true,
+				},
+				"log_http_bodies": {
+					Type:        schema.TypeBool,
+					Description: "log the bodies of env0 API requests and responses at TRACE level (of the env0_api_client subsystem) for troubleshooting. Credentials, secrets and sensitive configuration variable values are redacted. This can also be set via the ENV0_LOG_HTTP_BODIES environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("ENV0_LOG_HTTP_BODIES", false),
+					Optional:    true,
+				},
+				"audit_log_path": {
+					Type:        schema.TypeString,
+					Description: "the path of a file to append a JSON line to for every env0 API request that creates, updates or deletes an object (including retries): the timestamp, method, path, resource type and id, payload (with secrets redacted), status, request id and Terraform workspace. Requests that aren't sent (see dry_run) aren't recorded. This can also be set via the ENV0_AUDIT_LOG_PATH environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("ENV0_AUDIT_LOG_PATH", nil),
+					Optional:    true,
+				},
+				"usage_summary_path": {
+					Type:        schema.TypeString,
+					Description: "the path of a JSON file to write a summary of the env0 API usage to, when the provider shuts down. The summary has the number of requests, retries, 429 (rate limited) responses and errors, the cumulative latency and the cumulative rate limiter wait time, in total and per endpoint (ids in paths are replaced by {id}). The summary is always logged (at INFO level). With several provider configurations (aliases), use a different path for each. This can also be set via the ENV0_USAGE_SUMMARY_PATH environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("ENV0_USAGE_SUMMARY_PATH", nil),
+					Optional:    true,
+				},
+				"dry_run": {
+					Type:        schema.TypeBool,
+					Description: "log the env0 API requests that create, update or delete objects (at INFO level, with secrets redacted) instead of sending them. Such requests are answered with synthesized responses, so an apply shows which API calls it would make without changing anything in env0. The state of a dry run apply contains synthesized objects, so use it with a copy of the state (E.g. a separate workspace). This can also be set via the ENV0_DRY_RUN environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("ENV0_DRY_RUN", false),
+					Optional:    true,
+				},
+				"default_tags": defaultTagsSchema(),
+				"retry":        retrySchema(),
+				"rate_limit":   rateLimitSchema(),
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"env0_organization":              dataOrganization(),
@@ -85,6 +126,8 @@ func Provider(version string) plugin.ProviderFunc {
 				"env0_teams":                     dataTeams(),
 				"env0_environment":               dataEnvironment(),
 				"env0_environments":              dataEnvironments(),
+				"env0_environment_outputs":       dataEnvironmentOutputs(),
+				"env0_deployment":                dataDeployment(),
 				"env0_workflow_triggers":         dataWorkflowTriggers(),
 				"env0_notification":              dataNotification(),
 				"env0_notifications":             dataNotifications(),
@@ -137,6 +180,7 @@ func Provider(version string) plugin.ProviderFunc {
 				"env0_workflow_trigger":                          resourceWorkflowTrigger(),
 				"env0_environment_scheduling":                    resourceEnvironmentScheduling(),
 				"env0_environment_drift_detection":               resourceDriftDetection(),
+				"env0_environment_deployment":                    resourceEnvironmentDeployment(),
 				"env0_notification":                              resourceNotification(),
 				"env0_notification_project_assignment":           resourceNotificationProjectAssignment(),
 				"env0_module":                                    resourceModule(),
@@ -176,13 +220,17 @@ func Provider(version string) plugin.ProviderFunc {
 			},
 		}
 
+		maps.Copy(provider.Schema, transportSchema())
+
+		traceResources(provider)
+
 		provider.ConfigureContextFunc = configureProvider(version, provider)
 
 		return provider
 	}
 }
 
-func createRestyClient(ctx context.Context) *resty.Client {
+func createRestyClient(ctx context.Context, retry retryConfig, logHttpBodies bool) *resty.Client {
 	var isIntegrationTest bool
 
 	if os.Getenv("INTEGRATION_TESTS") == "1" {
@@ -191,12 +239,19 @@ func createRestyClient(ctx context.Context) *resty.Client {
 
 	subCtx := tflog.NewSubsystem(ctx, "env0_api_client")
 
-	return resty.New().SetRetryCount(10).
-		SetRetryWaitTime(time.Second + 5).
-		SetRetryMaxWaitTime(time.Second * 30).
+	readAfterCreate := http.NewReadAfterCreate(retry.readAfterCreateWindow)
+
+	restClient := resty.New().SetRetryCount(retry.maxRetries).
+		SetRetryWaitTime(retry.minWait).
+		SetRetryMaxWaitTime(retry.maxWait).
+		SetRetryAfter(http.RetryAfter).
 		OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
 			if r != nil {
 				tflog.SubsystemInfo(subCtx, "env0_api_client", "Sending request", map[string]any{"method": r.Method, "url": r.URL})
+
+				if logHttpBodies {
+					tflog.SubsystemTrace(subCtx, "env0_api_client", "Request body", http.RequestLogFields(r))
+				}
 			}
 
 			return nil
@@ -204,31 +259,62 @@ func createRestyClient(ctx context.Context) *resty.Client {
 		OnAfterResponse(func(c *resty.Client, r *resty.Response) error {
 			tflog.SubsystemInfo(subCtx, "env0_api_client", "Received response", map[string]any{"method": r.Request.Method, "url": r.Request.URL, "status": r.Status()})
 
+			if logHttpBodies {
+				tflog.SubsystemTrace(subCtx, "env0_api_client", "Response body", http.ResponseLogFields(r))
+			}
+
 			return nil
 		}).
 		AddRetryCondition(func(r *resty.Response, err error) bool {
-			if r == nil {
+			// A create request that failed without a response or with a server error may have been processed (E.g. a gateway timeout
+			// after the object was created). Retrying it may create a duplicate object, so the creates that the api client
+			// reconciles are not retried by default (see reconciledCreatePaths).
+			isNonRetryableCreate := !retry.retryPostRequests && r != nil && isReconciledCreate(r.Request)
+
+			if r == nil || err != nil {
+				if isNonRetryableCreate {
+					tflog.SubsystemWarn(subCtx, "env0_api_client", "No response to a create request, not retrying it", map[string]any{"url": r.Request.URL, "error": err.Error()})
+
+					return false
+				}
+
 				// No response. Possibly a networking issue (E.g. DNS lookup failure).
 				tflog.SubsystemWarn(subCtx, "env0_api_client", "No response, retrying request")
 
 				return true
 			}
 
-			// When running integration tests 404 may occur due to "database eventual consistency".
-			// Retry when there's a 5xx error. Otherwise do not retry.
-			if r.StatusCode() >= 500 || (isIntegrationTest && r.StatusCode() == 404) {
-				tflog.SubsystemWarn(subCtx, "env0_api_client", "Received a failed or not found response, retrying request", map[string]any{"method": r.Request.Method, "url": r.Request.URL, "status code": r.StatusCode()})
+			// Retry on rate limiting (429 Too Many Requests)
+			if r.StatusCode() == 429 && retry.isRetryableStatusCode(r.StatusCode()) {
+				tflog.SubsystemWarn(subCtx, "env0_api_client", "Rate limited, retrying request", map[string]any{"method": r.Request.Method, "url": r.Request.URL, "retry after": http.ParseRetryAfter(r.Header(), time.Now()).String()})
 
 				return true
 			}
 
-			// Retry on rate limiting (429 Too Many Requests)
-			if r.StatusCode() == 429 {
-				tflog.SubsystemWarn(subCtx, "env0_api_client", "Rate limited, retrying request", map[string]any{"method": r.Request.Method, "url": r.Request.URL})
+			if isNonRetryableCreate {
+				if retry.isRetryableStatusCode(r.StatusCode()) {
+					tflog.SubsystemWarn(subCtx, "env0_api_client", "Received a failed response to a create request, not retrying it", map[string]any{"url": r.Request.URL, "status code": r.StatusCode()})
+				}
+
+				return false
+			}
+
+			// Retry when there's a retryable (by default 5xx) error.
+			if retry.isRetryableStatusCode(r.StatusCode()) {
+				tflog.SubsystemWarn(subCtx, "env0_api_client", "Received a failed response, retrying request", map[string]any{"method": r.Request.Method, "url": r.Request.URL, "status code": r.StatusCode()})
+
+				return true
+			}
+
+			// A read right after a create may not find the object due to "database eventual consistency".
+			if readAfterCreate.ShouldRetry(r) {
+				tflog.SubsystemWarn(subCtx, "env0_api_client", "Received a not found response for a recently created object, retrying request", map[string]any{"method": r.Request.Method, "url": r.Request.URL})
 
 				return true
 			}
 
+			// When running integration tests an empty list may be returned due to "database eventual consistency".
+			// It isn't retried in production, since an empty list is a valid response that can't be told apart.
 			if r.StatusCode() == 200 && isIntegrationTest && r.String() == "[]" {
 				tflog.SubsystemWarn(subCtx, "env0_api_client", "Received an empty list , retrying request", map[string]any{"method": r.Request.Method, "url": r.Request.URL})
 
@@ -237,42 +323,112 @@ func createRestyClient(ctx context.Context) *resty.Client {
 
 			return false
 		})
+
+	readAfterCreate.Register(restClient)
+
+	return restClient
+}
+
+// logAdaptiveRateLimiterBudget logs the rate limiter's budget whenever it's adjusted due to a 429 response.
+func logAdaptiveRateLimiterBudget(ctx context.Context, restClient *resty.Client, rateLimiter ratelimiter.AdaptiveRateLimiter) {
+	subCtx := tflog.NewSubsystem(ctx, "env0_api_client")
+
+	restClient.OnAfterResponse(func(c *resty.Client, r *resty.Response) error {
+		if r.StatusCode() == 429 {
+			perWindow, available := rateLimiter.Budget()
+			tflog.SubsystemWarn(subCtx, "env0_api_client", "Rate limited, decreased the request budget", map[string]any{"requests per window": perWindow, "available requests": available})
+		}
+
+		return nil
+	})
 }
 
 func configureProvider(version string, p *schema.Provider) schema.ConfigureContextFunc {
 	userAgent := p.UserAgent("terraform-provider-env0", version)
 
 	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
-		apiKey, ok := d.GetOk("api_key")
-		if !ok {
-			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Detail: `The argument "api_key" is required, but no definition was found.`}}
+		credentials, err := resolveCredentials(d)
+		if err != nil {
+			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
+		}
+
+		skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
+
+		if missingArgument := credentials.missingArgument(); missingArgument != "" {
+			if !skipCredentialsValidation {
+				return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Detail: fmt.Sprintf("The argument %q is required, but no definition was found.", missingArgument)}}
+			}
+
+			tflog.Warn(ctx, "env0 credentials are missing, env0 API calls will fail", map[string]any{"missing argument": missingArgument})
+
+			err := fmt.Errorf("missing env0 credentials: the argument %q is required, but no definition was found", missingArgument)
+
+			return &providerMeta{
+				ApiClientInterface: client.NewApiClient(&missingCredentialsHttpClient{err: err}, credentials.organizationId),
+				defaultTags:        readDefaultTags(d),
+			}, nil
+		}
+
+		retry, err := readRetryConfig(d)
+		if err != nil {
+			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
+		}
+
+		rateLimit, err := readRateLimitConfig(d)
+		if err != nil {
+			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
 		}
 
-		apiSecret, ok := d.GetOk("api_secret")
-		if !ok {
-			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Detail: `The argument "api_secret" is required, but no definition was found.`}}
+		restClient := createRestyClient(ctx, retry, d.Get("log_http_bodies").(bool))
+
+		if err := readTransportConfig(d).apply(ctx, restClient); err != nil {
+			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
 		}
 
+		if err := configureAuditLog(ctx, d.Get("audit_log_path").(string), restClient); err != nil {
+			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
+		}
+
+		rateLimiter := rateLimit.newRateLimiter()
+
 		httpClient, err := http.NewHttpClient(http.HttpClientConfig{
-			ApiKey:      apiKey.(string),
-			ApiSecret:   apiSecret.(string),
-			ApiEndpoint: d.Get("api_endpoint").(string),
+			ApiKey:      credentials.apiKey,
+			ApiSecret:   credentials.apiSecret,
+			ApiEndpoint: credentials.apiEndpoint,
 			UserAgent:   userAgent,
-			RestClient:  createRestyClient(ctx),
-			// env0 backend allows 1000 requests / minute
-			RateLimiter: ratelimiter.NewSlidingWindowLimiter(950, time.Minute),
+			RestClient:  restClient,
+			RateLimiter: rateLimiter,
 		})
 		if err != nil {
 			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
 		}
 
-		apiClient := client.NewApiClient(httpClient, d.Get("organization_id").(string))
+		if adaptiveRateLimiter, ok := rateLimiter.(ratelimiter.AdaptiveRateLimiter); ok {
+			logAdaptiveRateLimiterBudget(ctx, restClient, adaptiveRateLimiter)
+		}
 
-		// organizations fetched to cache Auth0 API response.
-		if _, err := apiClient.OrganizationId(); err != nil {
-			return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
+		registerUsageReport(ctx, httpClient, d.Get("usage_summary_path").(string))
+
+		var apiHttpClient http.HttpClientInterface = httpClient
+
+		if d.Get("dry_run").(bool) {
+			tflog.Warn(ctx, "Dry run mode is enabled, env0 API requests that create, update or delete objects are logged and not sent")
+
+			apiHttpClient = http.NewDryRunHttpClient(httpClient)
+		}
+
+		apiClient := client.NewApiClient(apiHttpClient, credentials.organizationId)
+
+		if !skipCredentialsValidation {
+			// organizations fetched to cache Auth0 API response.
+			if _, err := apiClient.OrganizationId(); err != nil {
+				return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: err.Error()}}
+			}
 		}
 
-		return apiClient, nil
+		return &providerMeta{
+			ApiClientInterface: apiClient,
+			defaultTags:        readDefaultTags(d),
+		}, nil
 	}
 }
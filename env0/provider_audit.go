@@ -0,0 +1,83 @@
+package env0
+
+import (
+	"cmp"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultWorkspace = "default"
+
+// auditLogs are the audit logs of the configured providers, closed when the provider shuts down (see CloseAuditLogs).
+var auditLogs struct {
+	mu   sync.Mutex
+	logs []openAuditLog
+}
+
+type openAuditLog struct {
+	// ctx is the context the provider was configured with (it holds the provider's logger).
+	ctx      context.Context
+	auditLog *http.AuditLog
+	path     string
+}
+
+// terraformWorkspace returns the selected Terraform workspace. Terraform doesn't pass it to providers, so it's read the
+// same way Terraform does: the TF_WORKSPACE environment variable, or the "environment" file of the data directory
+// (TF_DATA_DIR, .terraform by default) of the working directory, which is the working directory of the provider.
+func terraformWorkspace() string {
+	if workspace := os.Getenv("TF_WORKSPACE"); workspace != "" {
+		return workspace
+	}
+
+	content, err := os.ReadFile(filepath.Join(cmp.Or(os.Getenv("TF_DATA_DIR"), ".terraform"), "environment"))
+	if err != nil {
+		return defaultWorkspace
+	}
+
+	return cmp.Or(strings.TrimSpace(string(content)), defaultWorkspace)
+}
+
+// configureAuditLog records the mutating requests of the rest client in the audit log file (if set).
+func configureAuditLog(ctx context.Context, path string, restClient *resty.Client) error {
+	if path == "" {
+		return nil
+	}
+
+	auditLog, err := http.NewAuditLog(path, terraformWorkspace())
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Recording env0 API requests that create, update or delete objects in the audit log", map[string]any{"path": path})
+
+	auditLog.Register(restClient)
+
+	auditLogs.mu.Lock()
+	defer auditLogs.mu.Unlock()
+
+	auditLogs.logs = append(auditLogs.logs, openAuditLog{ctx: context.WithoutCancel(ctx), auditLog: auditLog, path: path})
+
+	return nil
+}
+
+// CloseAuditLogs flushes the audit log files of the configured providers to disk and closes them.
+// It's called when the provider shuts down.
+func CloseAuditLogs() {
+	auditLogs.mu.Lock()
+	defer auditLogs.mu.Unlock()
+
+	for _, entry := range auditLogs.logs {
+		if err := entry.auditLog.Close(); err != nil {
+			tflog.Warn(entry.ctx, "Failed to close the audit log", map[string]any{"path": entry.path, "error": err.Error()})
+		}
+	}
+
+	auditLogs.logs = nil
+}
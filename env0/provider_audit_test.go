@@ -0,0 +1,75 @@
+package env0
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformWorkspace(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv("TF_DATA_DIR", dataDir)
+
+	t.Run("default", func(t *testing.T) {
+		t.Setenv("TF_WORKSPACE", "")
+
+		assert.Equal(t, "default", terraformWorkspace())
+	})
+
+	t.Run("selected workspace", func(t *testing.T) {
+		t.Setenv("TF_WORKSPACE", "")
+		require.NoError(t, os.WriteFile(filepath.Join(dataDir, "environment"), []byte("staging"), 0o600))
+
+		assert.Equal(t, "staging", terraformWorkspace())
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("TF_WORKSPACE", "production")
+
+		assert.Equal(t, "production", terraformWorkspace())
+	})
+}
+
+func TestConfigureAuditLog(t *testing.T) {
+	require.NoError(t, configureAuditLog(context.Background(), "", resty.New()))
+
+	err := configureAuditLog(context.Background(), filepath.Join(t.TempDir(), "missing", "audit.jsonl"), resty.New())
+	assert.ErrorContains(t, err, "failed to open the audit log")
+}
+
+func TestCloseAuditLogs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	restClient := resty.New()
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("DELETE", "https://fake.env0.com/teams/team0", httpmock.NewStringResponder(http.StatusOK, ""))
+	restClient.SetTransport(transport)
+
+	require.NoError(t, configureAuditLog(context.Background(), path, restClient))
+	require.Len(t, auditLogs.logs, 1)
+
+	_, err := restClient.R().Delete("https://fake.env0.com/teams/team0")
+	require.NoError(t, err)
+
+	CloseAuditLogs()
+	assert.Empty(t, auditLogs.logs)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"path":"/teams/team0"`)
+
+	// The closed audit log no longer records requests.
+	_, err = restClient.R().Delete("https://fake.env0.com/teams/team0")
+	require.NoError(t, err)
+
+	contentAfterClose, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(content), string(contentAfterClose))
+}
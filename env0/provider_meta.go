@@ -0,0 +1,30 @@
+package env0
+
+import (
+	"github.com/env0/terraform-provider-env0/client"
+)
+
+// providerMeta is the meta of the configured provider: the api client and the provider level settings that resources use.
+// Resources that only use the api client cast the meta to client.ApiClientInterface.
+type providerMeta struct {
+	client.ApiClientInterface
+
+	defaultTags map[string]string
+}
+
+// WithOrganizationId keeps the provider level settings (see withOrganizationId).
+func (m *providerMeta) WithOrganizationId(organizationId string) client.ApiClientInterface {
+	return &providerMeta{
+		ApiClientInterface: m.ApiClientInterface.WithOrganizationId(organizationId),
+		defaultTags:        m.defaultTags,
+	}
+}
+
+// defaultTags returns the "default_tags" of the provider.
+func defaultTags(meta any) map[string]string {
+	if m, ok := meta.(*providerMeta); ok {
+		return m.defaultTags
+	}
+
+	return nil
+}
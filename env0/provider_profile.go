@@ -0,0 +1,281 @@
+package env0
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	profileEnv               = "ENV0_PROFILE"
+	sharedCredentialsFileEnv = "ENV0_SHARED_CREDENTIALS_FILE"
+	defaultProfileName       = "default"
+	defaultApiEndpoint       = "https://api.env0.com/"
+)
+
+// credentialsProfile is a named profile of the shared credentials file (~/.env0/credentials).
+//
+// The file is either an INI file:
+//
+//	[default]
+//	api_key = ...
+//	api_secret = ...
+//
+//	[staging]
+//	api_key = ...
+//	api_secret = ...
+//	api_endpoint = https://api.staging.env0.com/
+//	organization_id = ...
+//
+// or a JSON object of profiles: {"default": {"api_key": "...", "api_secret": "..."}}.
+type credentialsProfile struct {
+	ApiKey         string `json:"api_key"`
+	ApiSecret      string `json:"api_secret"`
+	ApiEndpoint    string `json:"api_endpoint"`
+	OrganizationId string `json:"organization_id"`
+}
+
+func (p *credentialsProfile) set(key string, value string) error {
+	switch key {
+	case "api_key":
+		p.ApiKey = value
+	case "api_secret":
+		p.ApiSecret = value
+	case "api_endpoint":
+		p.ApiEndpoint = value
+	case "organization_id":
+		p.OrganizationId = value
+	default:
+		return fmt.Errorf("unknown key %q", key)
+	}
+
+	return nil
+}
+
+// providerCredentials are the resolved credentials and endpoint of the provider.
+type providerCredentials struct {
+	apiKey         string
+	apiSecret      string
+	apiEndpoint    string
+	organizationId string
+}
+
+// missingArgument returns the name of a required argument that wasn't resolved, or "" if none.
+func (c providerCredentials) missingArgument() string {
+	switch {
+	case c.apiKey == "":
+		return "api_key"
+	case c.apiSecret == "":
+		return "api_secret"
+	default:
+		return ""
+	}
+}
+
+// missingCredentialsHttpClient fails every request with err.
+// It's used when the credentials are missing and their validation is skipped (see "skip_credentials_validation").
+type missingCredentialsHttpClient struct {
+	err error
+}
+
+func (c *missingCredentialsHttpClient) WithContext(ctx context.Context) http.HttpClientInterface {
+	return c
+}
+
+func (c *missingCredentialsHttpClient) Get(path string, params map[string]string, response any) error {
+	return c.err
+}
+
+func (c *missingCredentialsHttpClient) Post(path string, request any, response any) error {
+	return c.err
+}
+
+func (c *missingCredentialsHttpClient) Put(path string, request any, response any) error {
+	return c.err
+}
+
+func (c *missingCredentialsHttpClient) Delete(path string, params map[string]string) error {
+	return c.err
+}
+
+func (c *missingCredentialsHttpClient) Patch(path string, request any, response any) error {
+	return c.err
+}
+
+func sharedCredentialsFilePath() (string, error) {
+	if path := os.Getenv(sharedCredentialsFileEnv); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find the home directory (set %s instead): %w", sharedCredentialsFileEnv, err)
+	}
+
+	return filepath.Join(home, ".env0", "credentials"), nil
+}
+
+// readCredentialsFile returns the profiles of the shared credentials file. Returns no profiles if the file doesn't exist.
+func readCredentialsFile(path string) (map[string]credentialsProfile, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]credentialsProfile{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the credentials file %s: %w", path, err)
+	}
+
+	profiles, err := parseCredentialsFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the credentials file %s: %w", path, err)
+	}
+
+	return profiles, nil
+}
+
+func parseCredentialsFile(content []byte) (map[string]credentialsProfile, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		var profiles map[string]credentialsProfile
+
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&profiles); err != nil {
+			return nil, err
+		}
+
+		return profiles, nil
+	}
+
+	return parseIniProfiles(content)
+}
+
+func parseIniProfiles(content []byte) (map[string]credentialsProfile, error) {
+	profiles := map[string]credentialsProfile{}
+
+	var (
+		name    string
+		profile *credentialsProfile
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			if profile != nil {
+				profiles[name] = *profile
+			}
+
+			name = strings.TrimSpace(line[1 : len(line)-1])
+			profile = &credentialsProfile{}
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected 'key = value' or '[profile]'", lineNumber)
+		}
+
+		if profile == nil {
+			return nil, fmt.Errorf("line %d: a key outside of a profile", lineNumber)
+		}
+
+		if err := profile.set(strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if profile != nil {
+		profiles[name] = *profile
+	}
+
+	return profiles, nil
+}
+
+// loadProfile returns the profile selected by the "profile" argument (or ENV0_PROFILE).
+// When no profile is selected, the "default" profile is used if it exists.
+func loadProfile(d *schema.ResourceData) (credentialsProfile, error) {
+	name := d.Get("profile").(string)
+
+	explicit := name != ""
+	if !explicit {
+		name = defaultProfileName
+	}
+
+	path, err := sharedCredentialsFilePath()
+	if err != nil {
+		if explicit {
+			return credentialsProfile{}, err
+		}
+
+		return credentialsProfile{}, nil
+	}
+
+	profiles, err := readCredentialsFile(path)
+	if err != nil {
+		return credentialsProfile{}, err
+	}
+
+	profile, ok := profiles[name]
+	if !ok && explicit {
+		return credentialsProfile{}, fmt.Errorf("profile %q was not found in the credentials file %s", name, path)
+	}
+
+	return profile, nil
+}
+
+// resolveCredentials resolves the credentials and endpoint of the provider. Every setting is resolved separately, in order of precedence:
+// 1. The provider argument.
+// 2. The environment variable (E.g. ENV0_API_KEY).
+// 3. The selected profile of the shared credentials file.
+// 4. The default value (api_endpoint only).
+//
+// The shared credentials file is only read if a profile is selected, or if the api_key or api_secret are missing.
+// Otherwise, a malformed file would fail a provider that doesn't use it.
+func resolveCredentials(d *schema.ResourceData) (providerCredentials, error) {
+	// Provider arguments and environment variables are both read by d.Get (see schema.EnvDefaultFunc).
+	credentials := providerCredentials{
+		apiKey:         d.Get("api_key").(string),
+		apiSecret:      d.Get("api_secret").(string),
+		apiEndpoint:    d.Get("api_endpoint").(string),
+		organizationId: d.Get("organization_id").(string),
+	}
+
+	if d.Get("profile").(string) != "" || credentials.missingArgument() != "" {
+		profile, err := loadProfile(d)
+		if err != nil {
+			return providerCredentials{}, err
+		}
+
+		credentials.apiKey = cmp.Or(credentials.apiKey, profile.ApiKey)
+		credentials.apiSecret = cmp.Or(credentials.apiSecret, profile.ApiSecret)
+		credentials.apiEndpoint = cmp.Or(credentials.apiEndpoint, profile.ApiEndpoint)
+		credentials.organizationId = cmp.Or(credentials.organizationId, profile.OrganizationId)
+	}
+
+	credentials.apiEndpoint = cmp.Or(credentials.apiEndpoint, defaultApiEndpoint)
+
+	return credentials, nil
+}
@@ -0,0 +1,165 @@
+package env0
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const iniCredentialsFile = `
+# env0 credentials
+[default]
+api_key = default-key
+api_secret = default-secret
+
+; staging
+[staging]
+api_key    = staging-key
+api_secret = staging=secret
+api_endpoint = https://api.staging.env0.com/
+organization_id = staging-organization
+`
+
+func writeCredentialsFile(t *testing.T, content string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	t.Setenv(sharedCredentialsFileEnv, path)
+}
+
+func TestParseCredentialsFile(t *testing.T) {
+	expected := map[string]credentialsProfile{
+		"default": {ApiKey: "default-key", ApiSecret: "default-secret"},
+		"staging": {
+			ApiKey:         "staging-key",
+			ApiSecret:      "staging=secret",
+			ApiEndpoint:    "https://api.staging.env0.com/",
+			OrganizationId: "staging-organization",
+		},
+	}
+
+	t.Run("ini", func(t *testing.T) {
+		profiles, err := parseCredentialsFile([]byte(iniCredentialsFile))
+		require.NoError(t, err)
+		assert.Equal(t, expected, profiles)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		profiles, err := parseCredentialsFile([]byte(`{
+			"default": {"api_key": "default-key", "api_secret": "default-secret"},
+			"staging": {"api_key": "staging-key", "api_secret": "staging=secret", "api_endpoint": "https://api.staging.env0.com/", "organization_id": "staging-organization"}
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, profiles)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for content, expectedError := range map[string]string{
+			"[default]\napi_token = key":      "line 2: unknown key \"api_token\"",
+			"[default]\napi_key":              "line 2: expected 'key = value'",
+			"api_key = key":                   "line 1: a key outside of a profile",
+			`{"default": {"api_token": "a"}}`: "unknown field \"api_token\"",
+			`{"default": `:                    "unexpected EOF",
+		} {
+			_, err := parseCredentialsFile([]byte(content))
+			assert.ErrorContains(t, err, expectedError, content)
+		}
+	})
+}
+
+func TestResolveCredentials(t *testing.T) {
+	// Cleared, so the profile isn't overridden by the environment variables set by TestMain.
+	t.Setenv("ENV0_API_KEY", "")
+	t.Setenv("ENV0_API_SECRET", "")
+
+	t.Run("default profile", func(t *testing.T) {
+		writeCredentialsFile(t, iniCredentialsFile)
+
+		credentials, err := resolveCredentials(providerResourceData(t, map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{apiKey: "default-key", apiSecret: "default-secret", apiEndpoint: defaultApiEndpoint}, credentials)
+	})
+
+	t.Run("named profile", func(t *testing.T) {
+		writeCredentialsFile(t, iniCredentialsFile)
+
+		credentials, err := resolveCredentials(providerResourceData(t, map[string]any{"profile": "staging"}))
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{
+			apiKey:         "staging-key",
+			apiSecret:      "staging=secret",
+			apiEndpoint:    "https://api.staging.env0.com/",
+			organizationId: "staging-organization",
+		}, credentials)
+	})
+
+	t.Run("profile environment variable", func(t *testing.T) {
+		writeCredentialsFile(t, iniCredentialsFile)
+		t.Setenv(profileEnv, "staging")
+
+		credentials, err := resolveCredentials(providerResourceData(t, map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, "staging-key", credentials.apiKey)
+	})
+
+	t.Run("arguments and environment variables take precedence", func(t *testing.T) {
+		writeCredentialsFile(t, iniCredentialsFile)
+		t.Setenv("ENV0_API_SECRET", "env-secret")
+		t.Setenv("ENV0_API_ENDPOINT", "https://api.env.env0.com/")
+
+		credentials, err := resolveCredentials(providerResourceData(t, map[string]any{
+			"profile": "staging",
+			"api_key": "argument-key",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{
+			apiKey:         "argument-key",
+			apiSecret:      "env-secret",
+			apiEndpoint:    "https://api.env.env0.com/",
+			organizationId: "staging-organization",
+		}, credentials)
+	})
+
+	t.Run("no credentials file", func(t *testing.T) {
+		t.Setenv(sharedCredentialsFileEnv, filepath.Join(t.TempDir(), "missing"))
+
+		credentials, err := resolveCredentials(providerResourceData(t, map[string]any{"api_key": "key", "api_secret": "secret"}))
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{apiKey: "key", apiSecret: "secret", apiEndpoint: defaultApiEndpoint}, credentials)
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		writeCredentialsFile(t, iniCredentialsFile)
+
+		_, err := resolveCredentials(providerResourceData(t, map[string]any{"profile": "production"}))
+		assert.ErrorContains(t, err, `profile "production" was not found`)
+	})
+
+	t.Run("invalid credentials file", func(t *testing.T) {
+		writeCredentialsFile(t, "[default]\napi_token = key")
+
+		_, err := resolveCredentials(providerResourceData(t, map[string]any{}))
+		assert.ErrorContains(t, err, "failed to parse the credentials file")
+	})
+
+	t.Run("invalid credentials file isn't read without a profile", func(t *testing.T) {
+		writeCredentialsFile(t, "[default]\napi_token = key")
+		t.Setenv("ENV0_API_SECRET", "env-secret")
+
+		credentials, err := resolveCredentials(providerResourceData(t, map[string]any{"api_key": "argument-key"}))
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{apiKey: "argument-key", apiSecret: "env-secret", apiEndpoint: defaultApiEndpoint}, credentials)
+	})
+
+	t.Run("invalid credentials file with a selected profile", func(t *testing.T) {
+		writeCredentialsFile(t, "[default]\napi_token = key")
+
+		_, err := resolveCredentials(providerResourceData(t, map[string]any{"profile": "default", "api_key": "key", "api_secret": "secret"}))
+		assert.ErrorContains(t, err, "failed to parse the credentials file")
+	})
+}
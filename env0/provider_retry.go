@@ -0,0 +1,378 @@
+package env0
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client/http/ratelimiter"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	retryMaxAttemptsEnv     = "ENV0_RETRY_MAX_ATTEMPTS"
+	retryMinWaitEnv         = "ENV0_RETRY_MIN_WAIT"
+	retryMaxWaitEnv         = "ENV0_RETRY_MAX_WAIT"
+	retryStatusCodesEnv     = "ENV0_RETRY_STATUS_CODES"
+	retryPostRequestsEnv    = "ENV0_RETRY_POST_REQUESTS"
+	retryReadAfterCreateEnv = "ENV0_RETRY_READ_AFTER_CREATE_WINDOW"
+	rateLimitRequestsEnv    = "ENV0_RATE_LIMIT_REQUESTS"
+	rateLimitWindowEnv      = "ENV0_RATE_LIMIT_WINDOW"
+	rateLimitAlgorithmEnv   = "ENV0_RATE_LIMIT_ALGORITHM"
+	rateLimitBurstEnv       = "ENV0_RATE_LIMIT_BURST"
+)
+
+const (
+	rateLimitAlgorithmSlidingWindow = "sliding_window"
+	rateLimitAlgorithmAdaptive      = "adaptive"
+)
+
+// retryConfig controls how failed API requests are retried.
+// Between attempts the client waits for the time requested by the server (Retry-After and rate limit headers),
+// or otherwise backs off exponentially with jitter, starting at minWait and capped at maxWait.
+type retryConfig struct {
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+	// statusCodes are the response status codes that are retried. When empty, 429 and 5xx responses are retried.
+	statusCodes []int
+	// retryPostRequests enables retrying the reconciled create requests (see reconciledCreatePaths) on network errors
+	// and retryable status codes other than 429. Disabled by default: a create that failed that way may have been processed
+	// by the server, and retrying it may create a duplicate object. These creates are reconciled by the api client instead
+	// (see client.createOrAdopt). Other POST requests are always retried.
+	retryPostRequests bool
+	// readAfterCreateWindow is how long after an object is created, reads of it that return 404 (Not Found) are retried.
+	// The env0 API is eventually consistent, so a read right after a create may not find the object. Zero disables it.
+	readAfterCreateWindow time.Duration
+}
+
+var defaultRetryConfig = retryConfig{
+	maxRetries: 10,
+	minWait:    time.Second,
+	maxWait:    time.Second * 30,
+
+	readAfterCreateWindow: time.Second * 30,
+}
+
+// reconciledCreatePaths are the paths of the create requests that the api client reconciles after an ambiguous failure
+// (see client.createOrAdopt). They're not retried on network errors and server errors, unless retryPostRequests is set.
+var reconciledCreatePaths = []string{
+	"/projects",
+	"/blueprints",
+	"/environments",
+	"/environments/without-template",
+	"/credentials",
+	"/teams",
+}
+
+// isReconciledCreate returns true if the request is a create request that the api client reconciles (see reconciledCreatePaths).
+func isReconciledCreate(r *resty.Request) bool {
+	if r == nil || r.Method != resty.MethodPost {
+		return false
+	}
+
+	var path string
+
+	if r.RawRequest != nil {
+		path = r.RawRequest.URL.Path
+	} else if parsed, err := url.Parse(r.URL); err == nil {
+		path = parsed.Path
+	}
+
+	return slices.Contains(reconciledCreatePaths, path)
+}
+
+func (c retryConfig) isRetryableStatusCode(statusCode int) bool {
+	if len(c.statusCodes) == 0 {
+		return statusCode >= 500 || statusCode == 429
+	}
+
+	return slices.Contains(c.statusCodes, statusCode)
+}
+
+// rateLimitConfig is the client side request budget: at most requests per window.
+type rateLimitConfig struct {
+	requests  int
+	window    time.Duration
+	algorithm string
+	// burst is the maximum number of requests sent at once (adaptive algorithm only).
+	burst int
+}
+
+// env0 backend allows 1000 requests / minute.
+var defaultRateLimitConfig = rateLimitConfig{
+	requests:  950,
+	window:    time.Minute,
+	algorithm: rateLimitAlgorithmSlidingWindow,
+}
+
+func (c rateLimitConfig) newRateLimiter() ratelimiter.RateLimiter {
+	if c.algorithm == rateLimitAlgorithmAdaptive {
+		return ratelimiter.NewTokenBucketLimiter(c.requests, c.window, c.burst)
+	}
+
+	return ratelimiter.NewSlidingWindowLimiter(c.requests, c.window)
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "configures how failed API requests are retried",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:             schema.TypeInt,
+					Description:      fmt.Sprintf("the maximum number of attempts (including the first request). This can also be set via the %s environment variable. Defaults to %d", retryMaxAttemptsEnv, defaultRetryConfig.maxRetries+1),
+					Optional:         true,
+					ValidateDiagFunc: NewGreaterThanValidator(0),
+				},
+				"min_wait": {
+					Type:             schema.TypeString,
+					Description:      fmt.Sprintf("the initial wait time between attempts, doubled (with jitter) on every attempt (e.g. \"500ms\", \"2s\"). A Retry-After response header takes precedence. This can also be set via the %s environment variable. Defaults to %s", retryMinWaitEnv, defaultRetryConfig.minWait),
+					Optional:         true,
+					ValidateDiagFunc: ValidateDuration,
+				},
+				"max_wait": {
+					Type:             schema.TypeString,
+					Description:      fmt.Sprintf("the maximum wait time between attempts (e.g. \"1m\"). This can also be set via the %s environment variable. Defaults to %s", retryMaxWaitEnv, defaultRetryConfig.maxWait),
+					Optional:         true,
+					ValidateDiagFunc: ValidateDuration,
+				},
+				"retryable_status_codes": {
+					Type:        schema.TypeList,
+					Description: fmt.Sprintf("the response status codes that are retried. This can also be set via the %s environment variable (comma separated). Defaults to 429 and all 5xx status codes", retryStatusCodesEnv),
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeInt,
+						ValidateDiagFunc: validateStatusCode,
+					},
+				},
+				"retry_post_requests": {
+					Type:        schema.TypeBool,
+					Description: fmt.Sprintf("retry the create requests of projects, templates, environments, credentials and teams on network errors and retryable status codes other than 429 (other POST requests are always retried). Not recommended: a create request that failed that way may have been processed, and retrying it may create duplicate objects (by default, the provider looks up an object whose create request failed that way, and adopts it if it was created). This can also be set via the %s environment variable. Defaults to false", retryPostRequestsEnv),
+					Optional:    true,
+				},
+				"read_after_create_window": {
+					Type:             schema.TypeString,
+					Description:      fmt.Sprintf("how long after an object is created, reads of it that return 404 (Not Found) are retried (e.g. \"1m\"). The env0 API is eventually consistent, so a read right after a create may not find the object for a short while. Set to \"0s\" to disable. This can also be set via the %s environment variable. Defaults to %s", retryReadAfterCreateEnv, defaultRetryConfig.readAfterCreateWindow),
+					Optional:         true,
+					ValidateDiagFunc: ValidateDuration,
+				},
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "configures the client side request budget of this provider instance. Useful when several pipelines share the organization's API rate limit",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"requests": {
+					Type:             schema.TypeInt,
+					Description:      fmt.Sprintf("the maximum number of requests per window. This can also be set via the %s environment variable. Defaults to %d", rateLimitRequestsEnv, defaultRateLimitConfig.requests),
+					Optional:         true,
+					ValidateDiagFunc: NewGreaterThanValidator(0),
+				},
+				"window": {
+					Type:             schema.TypeString,
+					Description:      fmt.Sprintf("the window duration (e.g. \"1m\"). This can also be set via the %s environment variable. Defaults to %s", rateLimitWindowEnv, defaultRateLimitConfig.window),
+					Optional:         true,
+					ValidateDiagFunc: ValidateDuration,
+				},
+				"algorithm": {
+					Type:             schema.TypeString,
+					Description:      fmt.Sprintf("the rate limiting algorithm: '%s' strictly enforces the request budget, '%s' uses a token bucket that allows bursts, slows down when the API responds with 429 (Too Many Requests) and speeds back up (up to the request budget) on sustained success. This can also be set via the %s environment variable. Defaults to '%s'", rateLimitAlgorithmSlidingWindow, rateLimitAlgorithmAdaptive, rateLimitAlgorithmEnv, defaultRateLimitConfig.algorithm),
+					Optional:         true,
+					ValidateDiagFunc: NewStringInValidator([]string{rateLimitAlgorithmSlidingWindow, rateLimitAlgorithmAdaptive}),
+				},
+				"burst": {
+					Type:             schema.TypeInt,
+					Description:      fmt.Sprintf("the maximum number of requests sent at once ('%s' algorithm only). This can also be set via the %s environment variable. Defaults to 5%% of the requests per window", rateLimitAlgorithmAdaptive, rateLimitBurstEnv),
+					Optional:         true,
+					ValidateDiagFunc: NewGreaterThanValidator(0),
+				},
+			},
+		},
+	}
+}
+
+func validateStatusCode(i any, path cty.Path) diag.Diagnostics {
+	statusCode := i.(int)
+	if statusCode < 100 || statusCode > 599 {
+		return diag.Errorf("%d is not a valid http status code", statusCode)
+	}
+
+	return nil
+}
+
+// blockSettings returns the settings of a "MaxItems: 1" block, or an empty map if the block isn't configured.
+func blockSettings(d *schema.ResourceData, key string) map[string]any {
+	list, ok := d.Get(key).([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return map[string]any{}
+	}
+
+	return list[0].(map[string]any)
+}
+
+// settingString returns a block setting, falling back to an environment variable.
+// Returns an empty string if neither is set.
+func settingString(settings map[string]any, key string, envKey string) string {
+	if value, ok := settings[key].(string); ok && value != "" {
+		return value
+	}
+
+	return os.Getenv(envKey)
+}
+
+func settingBool(settings map[string]any, key string, envKey string) (bool, error) {
+	if value, ok := settings[key].(bool); ok && value {
+		return true, nil
+	}
+
+	envValue := os.Getenv(envKey)
+	if envValue == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(envValue)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s value %q: must be a boolean", envKey, envValue)
+	}
+
+	return value, nil
+}
+
+func settingInt(settings map[string]any, key string, envKey string, defaultValue int) (int, error) {
+	if value, ok := settings[key].(int); ok && value != 0 {
+		return value, nil
+	}
+
+	envValue := os.Getenv(envKey)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(envValue)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid %s value %q: must be a positive integer", envKey, envValue)
+	}
+
+	return value, nil
+}
+
+func settingDuration(settings map[string]any, key string, envKey string, defaultValue time.Duration) (time.Duration, error) {
+	value := settingString(settings, key, envKey)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q: %w", key, value, err)
+	}
+
+	return duration, nil
+}
+
+func readRetryConfig(d *schema.ResourceData) (retryConfig, error) {
+	settings := blockSettings(d, "retry")
+
+	config := retryConfig{}
+
+	maxAttempts, err := settingInt(settings, "max_attempts", retryMaxAttemptsEnv, defaultRetryConfig.maxRetries+1)
+	if err != nil {
+		return config, err
+	}
+
+	config.maxRetries = maxAttempts - 1
+
+	if config.minWait, err = settingDuration(settings, "min_wait", retryMinWaitEnv, defaultRetryConfig.minWait); err != nil {
+		return config, err
+	}
+
+	if config.maxWait, err = settingDuration(settings, "max_wait", retryMaxWaitEnv, defaultRetryConfig.maxWait); err != nil {
+		return config, err
+	}
+
+	if config.minWait > config.maxWait {
+		return config, fmt.Errorf("retry min_wait (%s) may not be greater than max_wait (%s)", config.minWait, config.maxWait)
+	}
+
+	if statusCodes, ok := settings["retryable_status_codes"].([]any); ok && len(statusCodes) > 0 {
+		for _, statusCode := range statusCodes {
+			config.statusCodes = append(config.statusCodes, statusCode.(int))
+		}
+	} else if envValue := os.Getenv(retryStatusCodesEnv); envValue != "" {
+		for s := range strings.SplitSeq(envValue, ",") {
+			statusCode, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil || validateStatusCode(statusCode, nil).HasError() {
+				return config, fmt.Errorf("invalid %s value %q: must be a comma separated list of http status codes", retryStatusCodesEnv, envValue)
+			}
+
+			config.statusCodes = append(config.statusCodes, statusCode)
+		}
+	}
+
+	if config.retryPostRequests, err = settingBool(settings, "retry_post_requests", retryPostRequestsEnv); err != nil {
+		return config, err
+	}
+
+	if config.readAfterCreateWindow, err = settingDuration(settings, "read_after_create_window", retryReadAfterCreateEnv, defaultRetryConfig.readAfterCreateWindow); err != nil {
+		return config, err
+	}
+
+	if config.readAfterCreateWindow < 0 {
+		return config, fmt.Errorf("retry read_after_create_window (%s) may not be negative", config.readAfterCreateWindow)
+	}
+
+	return config, nil
+}
+
+func readRateLimitConfig(d *schema.ResourceData) (rateLimitConfig, error) {
+	settings := blockSettings(d, "rate_limit")
+
+	config := rateLimitConfig{}
+
+	var err error
+
+	if config.requests, err = settingInt(settings, "requests", rateLimitRequestsEnv, defaultRateLimitConfig.requests); err != nil {
+		return config, err
+	}
+
+	if config.window, err = settingDuration(settings, "window", rateLimitWindowEnv, defaultRateLimitConfig.window); err != nil {
+		return config, err
+	}
+
+	if config.window <= 0 {
+		return config, fmt.Errorf("rate_limit window must be a positive duration")
+	}
+
+	config.algorithm = settingString(settings, "algorithm", rateLimitAlgorithmEnv)
+	if config.algorithm == "" {
+		config.algorithm = defaultRateLimitConfig.algorithm
+	}
+
+	if config.algorithm != rateLimitAlgorithmSlidingWindow && config.algorithm != rateLimitAlgorithmAdaptive {
+		return config, fmt.Errorf("invalid %s value %q: must be one of: %s, %s", rateLimitAlgorithmEnv, config.algorithm, rateLimitAlgorithmSlidingWindow, rateLimitAlgorithmAdaptive)
+	}
+
+	if config.burst, err = settingInt(settings, "burst", rateLimitBurstEnv, max(1, config.requests/20)); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
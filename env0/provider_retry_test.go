@@ -0,0 +1,193 @@
+package env0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client/http/ratelimiter"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func providerResourceData(t *testing.T, raw map[string]any) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, Provider("")().Schema, raw)
+}
+
+func TestReadRetryConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		config, err := readRetryConfig(providerResourceData(t, map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, defaultRetryConfig, config)
+		assert.True(t, config.isRetryableStatusCode(429))
+		assert.True(t, config.isRetryableStatusCode(503))
+		assert.False(t, config.isRetryableStatusCode(404))
+	})
+
+	t.Run("block", func(t *testing.T) {
+		config, err := readRetryConfig(providerResourceData(t, map[string]any{
+			"retry": []any{map[string]any{
+				"max_attempts":             3,
+				"min_wait":                 "200ms",
+				"max_wait":                 "5s",
+				"retryable_status_codes":   []any{429, 502},
+				"retry_post_requests":      true,
+				"read_after_create_window": "1m",
+			}},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, retryConfig{
+			maxRetries:            2,
+			minWait:               200 * time.Millisecond,
+			maxWait:               5 * time.Second,
+			statusCodes:           []int{429, 502},
+			retryPostRequests:     true,
+			readAfterCreateWindow: time.Minute,
+		}, config)
+		assert.False(t, config.isRetryableStatusCode(500))
+		assert.True(t, config.isRetryableStatusCode(502))
+	})
+
+	t.Run("environment variables", func(t *testing.T) {
+		t.Setenv(retryMaxAttemptsEnv, "5")
+		t.Setenv(retryMinWaitEnv, "2s")
+		t.Setenv(retryMaxWaitEnv, "1m")
+		t.Setenv(retryStatusCodesEnv, "429, 503")
+		t.Setenv(retryPostRequestsEnv, "true")
+		t.Setenv(retryReadAfterCreateEnv, "0s")
+
+		config, err := readRetryConfig(providerResourceData(t, map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, retryConfig{
+			maxRetries:        4,
+			minWait:           2 * time.Second,
+			maxWait:           time.Minute,
+			statusCodes:       []int{429, 503},
+			retryPostRequests: true,
+		}, config)
+	})
+
+	t.Run("block wins over environment variables", func(t *testing.T) {
+		t.Setenv(retryMaxAttemptsEnv, "5")
+
+		config, err := readRetryConfig(providerResourceData(t, map[string]any{
+			"retry": []any{map[string]any{
+				"max_attempts": 1,
+			}},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 0, config.maxRetries)
+	})
+
+	t.Run("invalid environment variables", func(t *testing.T) {
+		for env, value := range map[string]string{
+			retryMaxAttemptsEnv:     "many",
+			retryMinWaitEnv:         "soon",
+			retryStatusCodesEnv:     "429,abc",
+			retryPostRequestsEnv:    "sometimes",
+			retryReadAfterCreateEnv: "-1s",
+		} {
+			t.Run(env, func(t *testing.T) {
+				t.Setenv(env, value)
+
+				_, err := readRetryConfig(providerResourceData(t, map[string]any{}))
+				assert.ErrorContains(t, err, value)
+			})
+		}
+	})
+
+	t.Run("min wait greater than max wait", func(t *testing.T) {
+		_, err := readRetryConfig(providerResourceData(t, map[string]any{
+			"retry": []any{map[string]any{
+				"min_wait": "1m",
+				"max_wait": "1s",
+			}},
+		}))
+		assert.ErrorContains(t, err, "may not be greater than max_wait")
+	})
+}
+
+func TestReadRateLimitConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		config, err := readRateLimitConfig(providerResourceData(t, map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, defaultRateLimitConfig.requests, config.requests)
+		assert.Equal(t, defaultRateLimitConfig.window, config.window)
+		assert.Equal(t, rateLimitAlgorithmSlidingWindow, config.algorithm)
+		assert.IsType(t, &ratelimiter.SlidingWindowLimiter{}, config.newRateLimiter())
+	})
+
+	t.Run("block", func(t *testing.T) {
+		config, err := readRateLimitConfig(providerResourceData(t, map[string]any{
+			"rate_limit": []any{map[string]any{
+				"requests": 300,
+				"window":   "30s",
+			}},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, rateLimitConfig{requests: 300, window: 30 * time.Second, algorithm: rateLimitAlgorithmSlidingWindow, burst: 15}, config)
+	})
+
+	t.Run("adaptive", func(t *testing.T) {
+		config, err := readRateLimitConfig(providerResourceData(t, map[string]any{
+			"rate_limit": []any{map[string]any{
+				"algorithm": rateLimitAlgorithmAdaptive,
+				"burst":     30,
+			}},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, rateLimitConfig{requests: defaultRateLimitConfig.requests, window: defaultRateLimitConfig.window, algorithm: rateLimitAlgorithmAdaptive, burst: 30}, config)
+
+		rateLimiter := config.newRateLimiter()
+		require.IsType(t, &ratelimiter.TokenBucketLimiter{}, rateLimiter)
+
+		perWindow, available := rateLimiter.(ratelimiter.AdaptiveRateLimiter).Budget()
+		assert.Equal(t, defaultRateLimitConfig.requests, perWindow)
+		assert.Equal(t, 30, available)
+	})
+
+	t.Run("environment variables", func(t *testing.T) {
+		t.Setenv(rateLimitRequestsEnv, "100")
+		t.Setenv(rateLimitWindowEnv, "10s")
+		t.Setenv(rateLimitAlgorithmEnv, rateLimitAlgorithmAdaptive)
+		t.Setenv(rateLimitBurstEnv, "7")
+
+		config, err := readRateLimitConfig(providerResourceData(t, map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, rateLimitConfig{requests: 100, window: 10 * time.Second, algorithm: rateLimitAlgorithmAdaptive, burst: 7}, config)
+	})
+
+	t.Run("invalid algorithm environment variable", func(t *testing.T) {
+		t.Setenv(rateLimitAlgorithmEnv, "leaky_bucket")
+
+		_, err := readRateLimitConfig(providerResourceData(t, map[string]any{}))
+		assert.ErrorContains(t, err, "leaky_bucket")
+	})
+
+	t.Run("invalid requests environment variable", func(t *testing.T) {
+		t.Setenv(rateLimitRequestsEnv, "-1")
+
+		_, err := readRateLimitConfig(providerResourceData(t, map[string]any{}))
+		assert.ErrorContains(t, err, rateLimitRequestsEnv)
+	})
+}
+
+func TestIsReconciledCreate(t *testing.T) {
+	newRequest := func(method string, url string) *resty.Request {
+		request := resty.New().R()
+		request.Method = method
+		request.URL = url
+
+		return request
+	}
+
+	assert.True(t, isReconciledCreate(newRequest(resty.MethodPost, "https://api.env0.com/projects")))
+	assert.True(t, isReconciledCreate(newRequest(resty.MethodPost, "https://api.env0.com/environments/without-template")))
+	assert.False(t, isReconciledCreate(newRequest(resty.MethodPost, "https://api.env0.com/projects/id0/move")))
+	assert.False(t, isReconciledCreate(newRequest(resty.MethodPost, "https://api.env0.com/api-keys")))
+	assert.False(t, isReconciledCreate(newRequest(resty.MethodPut, "https://api.env0.com/projects")))
+	assert.False(t, isReconciledCreate(nil))
+}
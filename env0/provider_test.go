@@ -1,22 +1,31 @@
 package env0
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/env0/terraform-provider-env0/client"
 	"github.com/env0/terraform-provider-env0/utils"
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -25,6 +34,8 @@ type testRestyClientSuite struct {
 	suite.Suite
 	client *resty.Client
 	url    string
+	// createUrl is the url of a create request that the api client reconciles (see reconciledCreatePaths).
+	createUrl string
 }
 
 // TestMain sets the shared env vars once, before any test runs. Doing this per-call in runUnitTest
@@ -39,6 +50,13 @@ func TestMain(m *testing.M) {
 func runUnitTest(t *testing.T, testCase resource.TestCase, mockFunc func(mockFunc *client.MockApiClientInterface)) {
 	t.Helper()
 
+	runUnitTestWithDefaultTags(t, nil, testCase, mockFunc)
+}
+
+// runUnitTestWithDefaultTags is like runUnitTest, for a provider that's configured with "default_tags".
+func runUnitTestWithDefaultTags(t *testing.T, defaultTags map[string]string, testCase resource.TestCase, mockFunc func(mockFunc *client.MockApiClientInterface)) {
+	t.Helper()
+
 	testPattern := os.Getenv("TEST_PATTERN")
 	if testPattern != "" && !strings.Contains(t.Name(), testPattern) {
 		t.SkipNow()
@@ -50,6 +68,8 @@ func runUnitTest(t *testing.T, testCase resource.TestCase, mockFunc func(mockFun
 	ctrl := gomock.NewController(&testReporter)
 
 	apiClientMock := client.NewMockApiClientInterface(ctrl)
+	apiClientMock.EXPECT().WithContext(gomock.Any()).Return(apiClientMock).AnyTimes()
+	apiClientMock.EXPECT().WithOrganizationId(gomock.Any()).Return(apiClientMock).AnyTimes()
 	mockFunc(apiClientMock)
 
 	testCase.ProviderFactories = map[string]func() (*schema.Provider, error){
@@ -57,6 +77,10 @@ func runUnitTest(t *testing.T, testCase resource.TestCase, mockFunc func(mockFun
 		"env0": func() (*schema.Provider, error) {
 			provider := Provider("")()
 			provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+				if defaultTags != nil {
+					return &providerMeta{ApiClientInterface: apiClientMock, defaultTags: defaultTags}, nil
+				}
+
 				return apiClientMock, nil
 			}
 
@@ -105,6 +129,9 @@ func testMissingConfig(t *testing.T, config map[string]any, expectedKey string)
 }
 
 func TestMissingConfigurations(t *testing.T) {
+	// Don't read the credentials of the machine running the tests.
+	t.Setenv(sharedCredentialsFileEnv, filepath.Join(t.TempDir(), "credentials"))
+
 	expectedApiKeyConfig := "api_key"
 	expectedApiSecretConfig := "api_secret"
 
@@ -135,6 +162,98 @@ func TestMissingConfigurations(t *testing.T) {
 	}
 }
 
+func TestSkipCredentialsValidation(t *testing.T) {
+	// Don't read the credentials of the machine running the tests.
+	t.Setenv(sharedCredentialsFileEnv, filepath.Join(t.TempDir(), "credentials"))
+
+	var organizationRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		organizationRequests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"organization0"}]`)
+	}))
+	defer server.Close()
+
+	configure := func(t *testing.T, config map[string]any) client.ApiClientInterface {
+		t.Helper()
+
+		organizationRequests.Store(0)
+
+		provider := Provider("TEST")()
+		diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+		require.False(t, diags.HasError(), diags)
+
+		return provider.Meta().(client.ApiClientInterface)
+	}
+
+	t.Run("validates credentials by default", func(t *testing.T) {
+		configure(t, map[string]any{"api_key": "key", "api_secret": "secret", "api_endpoint": server.URL})
+
+		assert.Equal(t, int32(1), organizationRequests.Load())
+	})
+
+	t.Run("resolves the organization once on first use", func(t *testing.T) {
+		apiClient := configure(t, map[string]any{"api_key": "key", "api_secret": "secret", "api_endpoint": server.URL, "skip_credentials_validation": true})
+
+		assert.Equal(t, int32(0), organizationRequests.Load())
+
+		var wg sync.WaitGroup
+
+		for range 10 {
+			wg.Go(func() {
+				organizationId, err := apiClient.OrganizationId()
+				assert.NoError(t, err)
+				assert.Equal(t, "organization0", organizationId)
+			})
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, int32(1), organizationRequests.Load())
+	})
+
+	t.Run("missing credentials fail on first use", func(t *testing.T) {
+		apiClient := configure(t, map[string]any{"api_secret": "secret", "skip_credentials_validation": true})
+
+		_, err := apiClient.Projects()
+		assert.ErrorContains(t, err, `the argument "api_key" is required`)
+	})
+}
+
+func TestDryRun(t *testing.T) {
+	t.Setenv(sharedCredentialsFileEnv, filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("ENV0_DRY_RUN", "1")
+
+	var methods []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"organization0"}]`)
+	}))
+	defer server.Close()
+
+	provider := Provider("TEST")()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{"api_key": "key", "api_secret": "secret", "api_endpoint": server.URL}))
+	require.False(t, diags.HasError(), diags)
+
+	apiClient := provider.Meta().(client.ApiClientInterface)
+
+	project, err := apiClient.ProjectCreate(client.ProjectCreatePayload{Name: "project"})
+	require.NoError(t, err)
+	assert.Equal(t, "project", project.Name)
+	assert.NotEmpty(t, project.Id)
+
+	readProject, err := apiClient.Project(project.Id)
+	require.NoError(t, err)
+	assert.Equal(t, project.Id, readProject.Id)
+
+	require.NoError(t, apiClient.ProjectDelete(project.Id))
+
+	assert.Equal(t, []string{"GET /organizations"}, methods)
+}
+
 func (suite *testRestyClientSuite) SetupTest() {
 	httpmock.Reset()
 }
@@ -178,6 +297,142 @@ func (suite *testRestyClientSuite) Test4xxResponse() {
 	assert.Equal(t, 1, httpmock.GetTotalCallCount())
 }
 
+func (suite *testRestyClientSuite) TestTooManyRequestsResponseHonorsRetryAfter() {
+	t := suite.T()
+
+	calls := 0
+
+	httpmock.RegisterResponder("GET", suite.url, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			res := httpmock.NewStringResponse(http.StatusTooManyRequests, "SLOW DOWN")
+			res.Header.Set("Retry-After", "0")
+
+			return res, nil
+		}
+
+		return httpmock.NewStringResponse(http.StatusOK, "OK"), nil
+	})
+
+	res, err := suite.client.R().Get(suite.url)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, res.StatusCode())
+	}
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func (suite *testRestyClientSuite) TestRetryBackoffIsCapped() {
+	t := suite.T()
+
+	httpmock.RegisterResponder("GET", suite.url, func(req *http.Request) (*http.Response, error) {
+		res := httpmock.NewStringResponse(http.StatusTooManyRequests, "SLOW DOWN")
+		// Larger than the max wait time - should be capped.
+		res.Header.Set("Retry-After", "3600")
+
+		return res, nil
+	})
+
+	start := time.Now()
+
+	res, err := suite.client.R().Get(suite.url)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode())
+	}
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func (suite *testRestyClientSuite) TestNetworkErrorIsRetried() {
+	t := suite.T()
+
+	calls := 0
+
+	httpmock.RegisterResponder("GET", suite.url, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("connection reset by peer")
+		}
+
+		return httpmock.NewStringResponse(http.StatusOK, "OK"), nil
+	})
+
+	res, err := suite.client.R().Get(suite.url)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, res.StatusCode())
+	}
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func (suite *testRestyClientSuite) TestCreateIsNotRetriedOnServerError() {
+	t := suite.T()
+
+	httpmock.RegisterResponder("POST", suite.createUrl, httpmock.NewStringResponder(http.StatusGatewayTimeout, "TIMEOUT"))
+
+	res, err := suite.client.R().Post(suite.createUrl)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusGatewayTimeout, res.StatusCode())
+	}
+
+	// Should be called once - the object may have been created.
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func (suite *testRestyClientSuite) TestCreateIsNotRetriedOnNetworkError() {
+	t := suite.T()
+
+	httpmock.RegisterResponder("POST", suite.createUrl, httpmock.NewErrorResponder(errors.New("connection reset by peer")))
+
+	_, err := suite.client.R().Post(suite.createUrl)
+
+	assert.ErrorContains(t, err, "connection reset by peer")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func (suite *testRestyClientSuite) TestOtherPostIsRetriedOnServerError() {
+	t := suite.T()
+
+	httpmock.RegisterResponder("POST", suite.url, httpmock.NewStringResponder(http.StatusBadGateway, "BAD"))
+
+	res, err := suite.client.R().Post(suite.url)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusBadGateway, res.StatusCode())
+	}
+
+	// Only the creates reconciled by the api client aren't retried.
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func (suite *testRestyClientSuite) TestCreateIsRetriedWhenRateLimited() {
+	t := suite.T()
+
+	calls := 0
+
+	httpmock.RegisterResponder("POST", suite.createUrl, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return httpmock.NewStringResponse(http.StatusTooManyRequests, "SLOW DOWN"), nil
+		}
+
+		return httpmock.NewStringResponse(http.StatusOK, "OK"), nil
+	})
+
+	res, err := suite.client.R().Post(suite.createUrl)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, res.StatusCode())
+	}
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
 // REMOVED - takes too long to run.
 // func (suite *testRestyClientSuite) Test5xxResponse() {
 // 	t := suite.T()
@@ -197,8 +452,156 @@ func (suite *testRestyClientSuite) Test4xxResponse() {
 
 func TestRestyClientSuite(t *testing.T) {
 	s := &testRestyClientSuite{
-		client: createRestyClient(context.Background()),
-		url:    "http://fake.env0.com/fake",
+		client: createRestyClient(context.Background(), retryConfig{
+			maxRetries: 3,
+			minWait:    time.Millisecond,
+			maxWait:    time.Millisecond * 50,
+		}, false),
+		url:       "http://fake.env0.com/fake",
+		createUrl: "http://fake.env0.com/projects",
 	}
 	suite.Run(t, s)
 }
+
+func TestRestyClientRetriesPostRequestsWhenEnabled(t *testing.T) {
+	restClient := createRestyClient(context.Background(), retryConfig{
+		maxRetries:        3,
+		minWait:           time.Millisecond,
+		maxWait:           time.Millisecond * 50,
+		retryPostRequests: true,
+	}, false)
+
+	const url = "https://fake.env0.com/environments"
+
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("POST", url, httpmock.NewStringResponder(http.StatusBadGateway, "BAD"))
+	restClient.SetTransport(transport)
+
+	res, err := restClient.R().Post(url)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, res.StatusCode())
+	assert.Equal(t, 4, transport.GetTotalCallCount())
+}
+
+func TestRestyClientRetriesReadsAfterCreate(t *testing.T) {
+	const baseUrl = "https://fake.env0.com"
+
+	newRestClient := func(window time.Duration) (*resty.Client, *httpmock.MockTransport) {
+		transport := httpmock.NewMockTransport()
+		transport.RegisterResponder("POST", baseUrl+"/projects", httpmock.NewStringResponder(http.StatusOK, `{"id": "project0"}`))
+		transport.RegisterResponder("GET", baseUrl+"/projects/project0", httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusNotFound, ""),
+			httpmock.NewStringResponse(http.StatusOK, `{"id": "project0"}`),
+		}))
+		transport.RegisterResponder("GET", baseUrl+"/projects/project1", httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+		restClient := createRestyClient(context.Background(), retryConfig{
+			maxRetries:            3,
+			minWait:               time.Millisecond,
+			maxWait:               time.Millisecond * 50,
+			readAfterCreateWindow: window,
+		}, false).SetTransport(transport).SetBaseURL(baseUrl)
+
+		return restClient, transport
+	}
+
+	t.Run("read of a created object", func(t *testing.T) {
+		restClient, transport := newRestClient(time.Minute)
+
+		_, err := restClient.R().Post("/projects")
+		require.NoError(t, err)
+
+		res, err := restClient.R().Get("/projects/project0")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode())
+		assert.Equal(t, 2, transport.GetCallCountInfo()["GET "+baseUrl+"/projects/project0"])
+
+		res, err = restClient.R().Get("/projects/project1")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode())
+		assert.Equal(t, 1, transport.GetCallCountInfo()["GET "+baseUrl+"/projects/project1"], "an object that wasn't created isn't retried")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		restClient, transport := newRestClient(0)
+
+		_, err := restClient.R().Post("/projects")
+		require.NoError(t, err)
+
+		res, err := restClient.R().Get("/projects/project0")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode())
+		assert.Equal(t, 1, transport.GetCallCountInfo()["GET "+baseUrl+"/projects/project0"])
+	})
+}
+
+func TestRestyClientLogsRedactedBodies(t *testing.T) {
+	const (
+		apiSecret       = "my-api-secret"
+		secretAccessKey = "my-secret-access-key"
+		accessKeyId     = "my-access-key-id"
+		sensitiveValue  = "my-sensitive-value"
+		apiKeySecret    = "my-api-key-secret"
+	)
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("POST", "https://fake.env0.com/credentials", httpmock.NewStringResponder(http.StatusOK, `{"id":"id0","name":"aws","value":{"accessKeyId":"`+accessKeyId+`"}}`))
+	transport.RegisterResponder("POST", "https://fake.env0.com/environments", httpmock.NewStringResponder(http.StatusOK, `{"id":"id1"}`))
+	transport.RegisterResponder("POST", "https://fake.env0.com/api-keys", httpmock.NewStringResponder(http.StatusOK, `{"id":"id2","apiKeySecret":"`+apiKeySecret+`"}`))
+
+	restClient := createRestyClient(ctx, retryConfig{}, true).SetTransport(transport).SetBaseURL("https://fake.env0.com")
+
+	_, err := restClient.R().SetBasicAuth("my-api-key", apiSecret).SetBody(client.AwsCredentialsCreatePayload{
+		Name: "aws",
+		Type: client.AwsAssumedRoleCredentialsType,
+		Value: client.AwsCredentialsValuePayload{
+			AccessKeyId:     accessKeyId,
+			SecretAccessKey: secretAccessKey,
+		},
+	}).Post("/credentials")
+	assert.NoError(t, err)
+
+	_, err = restClient.R().SetBasicAuth("my-api-key", apiSecret).SetBody(client.EnvironmentCreate{
+		Name: "environment",
+		ConfigurationChanges: &client.ConfigurationChanges{
+			{Name: "sensitive", Value: sensitiveValue, IsSensitive: new(true)},
+			{Name: "plain", Value: "my-plain-value"},
+		},
+	}).Post("/environments")
+	assert.NoError(t, err)
+
+	_, err = restClient.R().SetBasicAuth("my-api-key", apiSecret).SetBody(client.ApiKeyCreatePayload{Name: "key"}).Post("/api-keys")
+	assert.NoError(t, err)
+
+	logs := output.String()
+
+	assert.Contains(t, logs, "Request body")
+	assert.Contains(t, logs, "Response body")
+	assert.Contains(t, logs, "my-plain-value")
+	assert.Contains(t, logs, `\"accessKeyId\":\"[REDACTED]\"`)
+
+	for _, secret := range []string{apiSecret, secretAccessKey, accessKeyId, sensitiveValue, apiKeySecret, "bXktYXBpLWtleTpteS1hcGktc2VjcmV0"} {
+		assert.NotContains(t, logs, secret)
+	}
+}
+
+func TestRestyClientDoesNotLogBodiesByDefault(t *testing.T) {
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("POST", "https://fake.env0.com/projects", httpmock.NewStringResponder(http.StatusOK, `{"id":"id0"}`))
+
+	restClient := createRestyClient(ctx, retryConfig{}, false).SetTransport(transport).SetBaseURL("https://fake.env0.com")
+
+	_, err := restClient.R().SetBody(client.ProjectCreatePayload{Name: "my-project"}).Post("/projects")
+	assert.NoError(t, err)
+
+	assert.Contains(t, output.String(), "Sending request")
+	assert.NotContains(t, output.String(), "my-project")
+}
@@ -0,0 +1,219 @@
+package env0
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	httpProxyEnv          = "ENV0_HTTP_PROXY"
+	caCertFileEnv         = "ENV0_CA_CERT_FILE"
+	caCertPemEnv          = "ENV0_CA_CERT_PEM"
+	clientCertEnv         = "ENV0_CLIENT_CERT"
+	clientKeyEnv          = "ENV0_CLIENT_KEY"
+	insecureSkipVerifyEnv = "ENV0_INSECURE_SKIP_VERIFY"
+)
+
+const pemPrefix = "-----BEGIN"
+
+// transportConfig controls how the client connects to the env0 API (E.g. through a corporate egress proxy with TLS inspection).
+type transportConfig struct {
+	// proxy is the URL of the proxy. When empty, the standard HTTPS_PROXY / HTTP_PROXY / NO_PROXY environment variables are used.
+	proxy string
+	// caCertFile and caCertPem are CA certificates (PEM encoded) that are trusted in addition to the system's certificates.
+	caCertFile string
+	caCertPem  string
+	// clientCert and clientKey are a PEM encoded client certificate and private key (or paths of files that contain them) for mutual TLS.
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+}
+
+func transportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"http_proxy": {
+			Type:             schema.TypeString,
+			Description:      fmt.Sprintf("the URL of a proxy for env0 API requests (E.g. \"http://proxy.example.com:3128\"). This can also be set via the %s environment variable. Defaults to the standard HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables", httpProxyEnv),
+			DefaultFunc:      schema.EnvDefaultFunc(httpProxyEnv, nil),
+			Optional:         true,
+			ValidateDiagFunc: validateProxyUrl,
+		},
+		"ca_cert_file": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("the path of a PEM encoded CA certificate bundle to trust (in addition to the system's certificates) when connecting to the env0 API. Useful when a proxy inspects TLS traffic. This can also be set via the %s environment variable.", caCertFileEnv),
+			DefaultFunc: schema.EnvDefaultFunc(caCertFileEnv, nil),
+			Optional:    true,
+		},
+		"ca_cert_pem": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("a PEM encoded CA certificate bundle to trust (in addition to the system's certificates) when connecting to the env0 API. This can also be set via the %s environment variable.", caCertPemEnv),
+			DefaultFunc: schema.EnvDefaultFunc(caCertPemEnv, nil),
+			Optional:    true,
+		},
+		"client_cert": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("a PEM encoded client certificate (or the path of a file that contains it) for mutual TLS. Requires 'client_key'. This can also be set via the %s environment variable.", clientCertEnv),
+			DefaultFunc:  schema.EnvDefaultFunc(clientCertEnv, nil),
+			Optional:     true,
+			RequiredWith: []string{"client_key"},
+		},
+		"client_key": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("a PEM encoded private key of the client certificate (or the path of a file that contains it) for mutual TLS. Requires 'client_cert'. This can also be set via the %s environment variable.", clientKeyEnv),
+			DefaultFunc:  schema.EnvDefaultFunc(clientKeyEnv, nil),
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"client_cert"},
+		},
+		"insecure_skip_verify": {
+			Type:        schema.TypeBool,
+			Description: fmt.Sprintf("skip the verification of the env0 API's TLS certificate. Insecure, use only for troubleshooting. This can also be set via the %s environment variable.", insecureSkipVerifyEnv),
+			DefaultFunc: schema.EnvDefaultFunc(insecureSkipVerifyEnv, false),
+			Optional:    true,
+		},
+	}
+}
+
+func validateProxyUrl(i any, path cty.Path) diag.Diagnostics {
+	if _, err := parseProxyUrl(i.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func parseProxyUrl(value string) (*url.URL, error) {
+	proxyUrl, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy url %q: %w", value, err)
+	}
+
+	switch proxyUrl.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy url %q: the scheme must be one of: http, https, socks5", value)
+	}
+
+	if proxyUrl.Host == "" {
+		return nil, fmt.Errorf("invalid proxy url %q: missing host", value)
+	}
+
+	return proxyUrl, nil
+}
+
+func readTransportConfig(d *schema.ResourceData) transportConfig {
+	return transportConfig{
+		proxy:              d.Get("http_proxy").(string),
+		caCertFile:         d.Get("ca_cert_file").(string),
+		caCertPem:          d.Get("ca_cert_pem").(string),
+		clientCert:         d.Get("client_cert").(string),
+		clientKey:          d.Get("client_key").(string),
+		insecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+}
+
+// pemOrFile returns value if it's PEM encoded, otherwise the content of the file at path value.
+func pemOrFile(name string, value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), pemPrefix) {
+		return []byte(value), nil
+	}
+
+	content, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	return content, nil
+}
+
+func (c transportConfig) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.insecureSkipVerify,
+	}
+
+	if c.caCertFile != "" || c.caCertPem != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if c.caCertFile != "" {
+			content, err := os.ReadFile(c.caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+			}
+
+			if !rootCAs.AppendCertsFromPEM(content) {
+				return nil, fmt.Errorf("ca_cert_file %s does not contain a PEM encoded certificate", c.caCertFile)
+			}
+		}
+
+		if c.caCertPem != "" && !rootCAs.AppendCertsFromPEM([]byte(c.caCertPem)) {
+			return nil, fmt.Errorf("ca_cert_pem does not contain a PEM encoded certificate")
+		}
+
+		config.RootCAs = rootCAs
+	}
+
+	if c.clientCert != "" || c.clientKey != "" {
+		if c.clientCert == "" || c.clientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be set together")
+		}
+
+		certPem, err := pemOrFile("client_cert", c.clientCert)
+		if err != nil {
+			return nil, err
+		}
+
+		keyPem, err := pemOrFile("client_key", c.clientKey)
+		if err != nil {
+			return nil, err
+		}
+
+		certificate, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// apply configures the proxy and TLS settings of the rest client's transport.
+func (c transportConfig) apply(ctx context.Context, restClient *resty.Client) error {
+	if c.proxy != "" {
+		// Validate (the setting may come from the environment variable, which isn't validated by the schema).
+		if _, err := parseProxyUrl(c.proxy); err != nil {
+			return err
+		}
+
+		restClient.SetProxy(c.proxy)
+	}
+
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return err
+	}
+
+	if c.insecureSkipVerify {
+		tflog.Warn(ctx, "TLS certificate verification of the env0 API is disabled (insecure_skip_verify)")
+	}
+
+	restClient.SetTLSClientConfig(tlsConfig)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package env0
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// usageReport is the env0 API usage of a configured provider, reported when the provider shuts down (see ReportUsage).
+type usageReport struct {
+	// ctx is the context the provider was configured with (it holds the provider's logger).
+	ctx        context.Context
+	httpClient *http.HttpClient
+	// path is the "usage_summary_path" of the provider (optional).
+	path string
+}
+
+var usageReports struct {
+	mu      sync.Mutex
+	reports []usageReport
+}
+
+func registerUsageReport(ctx context.Context, httpClient *http.HttpClient, path string) {
+	usageReports.mu.Lock()
+	defer usageReports.mu.Unlock()
+
+	usageReports.reports = append(usageReports.reports, usageReport{
+		ctx:        context.WithoutCancel(ctx),
+		httpClient: httpClient,
+		path:       path,
+	})
+}
+
+// ReportUsage logs a summary of the env0 API usage of the configured providers (per endpoint: requests, retries,
+// 429 responses, cumulative latency and rate limiter wait time), and writes it to the "usage_summary_path" (if set).
+// It's called when the provider shuts down.
+func ReportUsage() {
+	usageReports.mu.Lock()
+	defer usageReports.mu.Unlock()
+
+	for _, report := range usageReports.reports {
+		summary := report.httpClient.Usage()
+
+		logUsageSummary(report.ctx, summary)
+
+		if report.path != "" {
+			if err := writeUsageSummary(report.path, summary); err != nil {
+				tflog.Warn(report.ctx, "Failed to write the env0 API usage summary", map[string]any{"path": report.path, "error": err.Error()})
+			}
+		}
+	}
+
+	usageReports.reports = nil
+}
+
+func usageFields(usage http.EndpointUsage) map[string]any {
+	return map[string]any{
+		"requests":             usage.Requests,
+		"retries":              usage.Retries,
+		"rate_limited":         usage.RateLimited,
+		"errors":               usage.Errors,
+		"latency_ms":           usage.LatencyMs,
+		"rate_limiter_wait_ms": usage.RateLimiterWaitMs,
+	}
+}
+
+func logUsageSummary(ctx context.Context, summary http.UsageSummary) {
+	if summary.Total.Requests == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "env0 API usage summary", usageFields(summary.Total))
+
+	for _, usage := range summary.Endpoints {
+		fields := usageFields(usage)
+		fields["endpoint"] = usage.Method + " " + usage.Path
+
+		tflog.Info(ctx, "env0 API usage of an endpoint", fields)
+	}
+}
+
+func writeUsageSummary(path string, summary http.UsageSummary) error {
+	content, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, append(content, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write the usage summary file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package env0
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client/http"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportUsage(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := http.NewHttpClient(http.HttpClientConfig{ApiEndpoint: server.URL, RestClient: resty.New()})
+	require.NoError(t, err)
+
+	var response map[string]any
+
+	require.NoError(t, httpClient.Get("/environments/8a9b0c1d-2e3f-4a5b-8c6d-7e8f9a0b1c2d", nil, &response))
+	require.NoError(t, httpClient.Get("/environments/9b0c1d2e-3f4a-4b5c-9d7e-8f9a0b1c2d3e", nil, &response))
+
+	path := filepath.Join(t.TempDir(), "usage.json")
+
+	registerUsageReport(context.Background(), httpClient, path)
+	// A report that can't be written doesn't fail the others.
+	registerUsageReport(context.Background(), httpClient, filepath.Join(t.TempDir(), "missing", "usage.json"))
+
+	ReportUsage()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var summary struct {
+		Total struct {
+			Requests int64 `json:"requests"`
+		} `json:"total"`
+		Endpoints []struct {
+			Method   string `json:"method"`
+			Path     string `json:"path"`
+			Requests int64  `json:"requests"`
+		} `json:"endpoints"`
+	}
+
+	require.NoError(t, json.Unmarshal(content, &summary))
+	assert.Equal(t, int64(2), summary.Total.Requests)
+	require.Len(t, summary.Endpoints, 1)
+	assert.Equal(t, "GET", summary.Endpoints[0].Method)
+	assert.Equal(t, "/environments/{id}", summary.Endpoints[0].Path)
+	assert.Equal(t, int64(2), summary.Endpoints[0].Requests)
+
+	assert.Empty(t, usageReports.reports)
+}
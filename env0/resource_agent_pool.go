@@ -114,7 +114,7 @@ func setAgentPoolSchema(d *schema.ResourceData, agentPool *client.AgentPool) {
 }
 
 func resourceAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	payload := client.AgentPoolCreatePayload{
 		Name:        d.Get("name").(string),
@@ -124,7 +124,7 @@ func resourceAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta a
 
 	agentPool, err := apiClient.AgentPoolCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create agent pool: %v", err)
+		return ApiFailure("could not create agent pool", d, err)
 	}
 
 	d.SetId(agentPool.Id)
@@ -133,7 +133,7 @@ func resourceAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func resourceAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	agentPool, err := apiClient.AgentPool(d.Id())
 	if err != nil {
@@ -146,7 +146,7 @@ func resourceAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	payload := client.AgentPoolUpdatePayload{
 		Name:        d.Get("name").(string),
@@ -156,14 +156,14 @@ func resourceAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta a
 
 	_, err := apiClient.AgentPoolUpdate(d.Id(), payload)
 	if err != nil {
-		return diag.Errorf("could not update agent pool: %v", err)
+		return ApiFailure("could not update agent pool", d, err)
 	}
 
 	return resourceAgentPoolRead(ctx, d, meta)
 }
 
 func resourceAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.AgentPoolDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete agent pool: %v", err)
@@ -172,8 +172,8 @@ func resourceAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta a
 	return nil
 }
 
-func getAgentPoolByName(name string, meta any) (*client.AgentPool, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getAgentPoolByName(ctx context.Context, name string, meta any) (*client.AgentPool, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	pools, err := apiClient.AgentPools()
 	if err != nil {
@@ -200,7 +200,7 @@ func getAgentPoolByName(name string, meta any) (*client.AgentPool, error) {
 }
 
 func resourceAgentPoolImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	// Try by id first; fall back to name lookup only on not-found.
 	agentPool, err := apiClient.AgentPool(d.Id())
@@ -212,7 +212,7 @@ func resourceAgentPoolImport(ctx context.Context, d *schema.ResourceData, meta a
 
 		tflog.Info(ctx, "Agent pool not found by id, trying by name", map[string]any{"id": d.Id()})
 
-		agentPool, err = getAgentPoolByName(d.Id(), meta)
+		agentPool, err = getAgentPoolByName(ctx, d.Id(), meta)
 		if err != nil {
 			return nil, fmt.Errorf("could not get agent pool: %w", err)
 		}
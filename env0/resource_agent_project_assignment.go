@@ -17,8 +17,8 @@ type AgentProjectAssignment struct {
 
 const ENV0_DEFAULT = "ENV0_DEFAULT"
 
-func getAgentProjectAssignment(d *schema.ResourceData, meta any) (*AgentProjectAssignment, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getAgentProjectAssignment(ctx context.Context, d *schema.ResourceData, meta any) (*AgentProjectAssignment, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignments, err := apiClient.ProjectsAgentsAssignments()
 	if err != nil {
@@ -71,7 +71,7 @@ func resourceAgentProjectAssignment() *schema.Resource {
 }
 
 func resourceAgentProjectAssignmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignment AgentProjectAssignment
 	if err := readResourceData(&assignment, d); err != nil {
@@ -92,7 +92,7 @@ func resourceAgentProjectAssignmentCreateOrUpdate(ctx context.Context, d *schema
 }
 
 func resourceAgentProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	assignment, err := getAgentProjectAssignment(d, meta)
+	assignment, err := getAgentProjectAssignment(ctx, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -105,7 +105,7 @@ func resourceAgentProjectAssignmentRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAgentProjectAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	// When deleting an assignment, revert the project assignment to the default.
 
@@ -127,14 +127,14 @@ func resourceAgentProjectAssignmentDelete(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAgentProjectAssignmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	// Validate the project exists.
 	if _, err := apiClient.Project(d.Id()); err != nil {
 		return nil, fmt.Errorf("unable to get or find a project with id '%s': %w", d.Id(), err)
 	}
 
-	assignment, err := getAgentProjectAssignment(d, meta)
+	assignment, err := getAgentProjectAssignment(ctx, d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ func resourceAgentSecret() *schema.Resource {
 }
 
 func resourceAgentSecretCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	agentId := d.Get("agent_id").(string)
 
@@ -53,7 +53,7 @@ func resourceAgentSecretCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	agentSecret, err := apiClient.AgentSecretCreate(agentId, payload)
 	if err != nil {
-		return diag.Errorf("could not create agent secret: %v", err)
+		return ApiFailure("could not create agent secret", d, err)
 	}
 
 	d.SetId(agentSecret.Id)
@@ -63,7 +63,7 @@ func resourceAgentSecretCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceAgentSecretRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	agentId := d.Get("agent_id").(string)
 
@@ -88,7 +88,7 @@ func resourceAgentSecretRead(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func resourceAgentSecretDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	agentId := d.Get("agent_id").(string)
 
@@ -109,7 +109,7 @@ func resourceAgentSecretImport(ctx context.Context, d *schema.ResourceData, meta
 	agentId := parts[0]
 	secretId := parts[1]
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	secrets, err := apiClient.AgentSecrets(agentId)
 	if err != nil {
@@ -27,6 +27,7 @@ func resourceApiKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceApiKeyImport},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "the api key name",
@@ -96,7 +97,9 @@ func resourceApiKey() *schema.Resource {
 }
 
 func resourceApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organizationRole := d.Get("organization_role").(string)
 	if organizationRole == organizationRoleAdmin && len(d.Get("project_permissions").(*schema.Set).List()) > 0 {
@@ -113,7 +116,7 @@ func resourceApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	apiKey, err := apiClient.ApiKeyCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create api key: %v", err)
+		return ApiFailure("could not create api key", d, err)
 	}
 
 	if err := writeResourceData(apiKey, d); err != nil {
@@ -128,7 +131,9 @@ func resourceApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceApiKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiKey, err := getApiKeyById(d.Id(), meta)
+	meta = withOrganizationId(d, meta)
+
+	apiKey, err := getApiKeyById(ctx, d.Id(), meta)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			tflog.Warn(ctx, "Drift Detected: Terraform will remove id from state", map[string]any{"id": d.Id()})
@@ -150,7 +155,9 @@ func resourceApiKeyRead(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func resourceApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.ApiKeyDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete api key: %v", err)
@@ -159,25 +166,23 @@ func resourceApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return nil
 }
 
-func getApiKeyById(id string, meta any) (*client.ApiKey, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getApiKeyById(ctx context.Context, id string, meta any) (*client.ApiKey, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
-	apiKeys, err := apiClient.ApiKeys()
+	apiKey, found, err := client.FindFirst(apiClient.ApiKeysIter(), func(candidate client.ApiKey) bool { return candidate.Id == id })
 	if err != nil {
 		return nil, err
 	}
 
-	for _, apiKey := range apiKeys {
-		if apiKey.Id == id {
-			return &apiKey, nil
-		}
+	if !found {
+		return nil, ErrNotFound
 	}
 
-	return nil, ErrNotFound
+	return &apiKey, nil
 }
 
-func getApiKeyByName(name string, meta any) (*client.ApiKey, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getApiKeyByName(ctx context.Context, name string, meta any) (*client.ApiKey, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	apiKeys, err := apiClient.ApiKeys()
 	if err != nil {
@@ -208,15 +213,17 @@ func getApiKey(ctx context.Context, id string, meta any) (*client.ApiKey, error)
 	if err == nil {
 		tflog.Info(ctx, "Resolving api key by id", map[string]any{"id": id})
 
-		return getApiKeyById(id, meta)
+		return getApiKeyById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving api key by name", map[string]any{"name": id})
 
-		return getApiKeyByName(id, meta)
+		return getApiKeyByName(ctx, id, meta)
 	}
 }
 
 func resourceApiKeyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	apiKey, err := getApiKey(ctx, d.Id(), meta)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
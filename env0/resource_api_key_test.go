@@ -102,13 +102,13 @@ func TestUnitApiKeyResource(t *testing.T) {
 					Name:        apiKey.Name,
 					Permissions: client.ApiKeyPermissions{OrganizationRole: "Admin"},
 				}).Times(1).Return(&apiKey, nil),
-				mock.EXPECT().ApiKeys().Times(2).Return([]client.ApiKey{apiKey}, nil),
+				mock.EXPECT().ApiKeysIter().Times(2).Return(seqOf(apiKey)),
 				mock.EXPECT().ApiKeyDelete(apiKey.Id).Times(1),
 				mock.EXPECT().ApiKeyCreate(client.ApiKeyCreatePayload{
 					Name:        updatedApiKey.Name,
 					Permissions: client.ApiKeyPermissions{OrganizationRole: "Admin"},
 				}).Times(1).Return(&updatedApiKey, nil),
-				mock.EXPECT().ApiKeys().Times(1).Return([]client.ApiKey{updatedApiKey}, nil),
+				mock.EXPECT().ApiKeysIter().Times(1).Return(seqOf(updatedApiKey)),
 				mock.EXPECT().ApiKeyDelete(updatedApiKey.Id).Times(1),
 			)
 		})
@@ -139,7 +139,7 @@ func TestUnitApiKeyResource(t *testing.T) {
 						OrganizationRole: apiKeyUser.OrganizationRole,
 					},
 				}).Times(1).Return(&apiKeyUser, nil),
-				mock.EXPECT().ApiKeys().Times(1).Return([]client.ApiKey{apiKeyUser}, nil),
+				mock.EXPECT().ApiKeysIter().Times(1).Return(seqOf(apiKeyUser)),
 				mock.EXPECT().ApiKeyDelete(apiKeyUser.Id).Times(1),
 			)
 		})
@@ -172,7 +172,7 @@ func TestUnitApiKeyResource(t *testing.T) {
 						OrganizationRole: apiKey.OrganizationRole,
 					},
 				}).Times(1).Return(&apiKey, nil),
-				mock.EXPECT().ApiKeys().Times(1).Return([]client.ApiKey{apiKey}, nil),
+				mock.EXPECT().ApiKeysIter().Times(1).Return(seqOf(apiKey)),
 				mock.EXPECT().ApiKeyDelete(apiKey.Id).Times(1),
 			)
 		})
@@ -220,7 +220,8 @@ func TestUnitApiKeyResource(t *testing.T) {
 				Name:        apiKey.Name,
 				Permissions: client.ApiKeyPermissions{OrganizationRole: "Admin"},
 			}).Times(1).Return(&apiKey, nil)
-			mock.EXPECT().ApiKeys().Times(3).Return([]client.ApiKey{apiKey}, nil)
+			mock.EXPECT().ApiKeys().Times(1).Return([]client.ApiKey{apiKey}, nil)
+			mock.EXPECT().ApiKeysIter().Times(2).Return(seqOf(apiKey))
 			mock.EXPECT().ApiKeyDelete(apiKey.Id).Times(1)
 		})
 	})
@@ -247,7 +248,7 @@ func TestUnitApiKeyResource(t *testing.T) {
 				Name:        apiKey.Name,
 				Permissions: client.ApiKeyPermissions{OrganizationRole: "Admin"},
 			}).Times(1).Return(&apiKey, nil)
-			mock.EXPECT().ApiKeys().Times(3).Return([]client.ApiKey{apiKey}, nil)
+			mock.EXPECT().ApiKeysIter().Times(3).Return(seqOf(apiKey))
 			mock.EXPECT().ApiKeyDelete(apiKey.Id).Times(1)
 		})
 	})
@@ -275,7 +276,7 @@ func TestUnitApiKeyResource(t *testing.T) {
 				Name:        updatedApiKey.Name,
 				Permissions: client.ApiKeyPermissions{OrganizationRole: "Admin"},
 			}).Times(1).Return(&updatedApiKey, nil)
-			mock.EXPECT().ApiKeys().Times(2).Return([]client.ApiKey{updatedApiKey}, nil)
+			mock.EXPECT().ApiKeysIter().Times(2).Return(seqOf(updatedApiKey))
 			mock.EXPECT().ApiKeyDelete(updatedApiKey.Id).Times(1)
 		})
 	})
@@ -316,7 +317,7 @@ func TestUnitApiKeyResource(t *testing.T) {
 						},
 					},
 				}).Times(1).Return(&apiKeyWithProjectPermissions, nil),
-				mock.EXPECT().ApiKeys().Times(1).Return([]client.ApiKey{apiKeyWithProjectPermissions}, nil),
+				mock.EXPECT().ApiKeysIter().Times(1).Return(seqOf(apiKeyWithProjectPermissions)),
 				mock.EXPECT().ApiKeyDelete(apiKeyWithProjectPermissions.Id).Times(1),
 			)
 		})
@@ -358,7 +359,7 @@ func TestUnitApiKeyResource(t *testing.T) {
 						},
 					},
 				}).Times(1).Return(&apiKeyWithCustomRole, nil),
-				mock.EXPECT().ApiKeys().Times(1).Return([]client.ApiKey{apiKeyWithCustomRole}, nil),
+				mock.EXPECT().ApiKeysIter().Times(1).Return(seqOf(apiKeyWithCustomRole)),
 				mock.EXPECT().ApiKeyDelete(apiKeyWithCustomRole.Id).Times(1),
 			)
 		})
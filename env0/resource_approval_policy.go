@@ -25,7 +25,7 @@ func resourceApprovalPolicy() *schema.Resource {
 }
 
 func resourceApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ApprovalPolicyCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -38,7 +38,7 @@ func resourceApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 
 	approvalPolicy, err := apiClient.ApprovalPolicyCreate(&payload)
 	if err != nil {
-		return diag.Errorf("failed to create approval policy: %v", err)
+		return ApiFailure("failed to create approval policy", d, err)
 	}
 
 	d.SetId(approvalPolicy.Id)
@@ -47,7 +47,7 @@ func resourceApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceApprovalPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	approvalPolicy, err := apiClient.Template(d.Id())
 	if err != nil {
@@ -78,7 +78,7 @@ func resourceApprovalPolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ApprovalPolicyUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -86,14 +86,14 @@ func resourceApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if _, err := apiClient.ApprovalPolicyUpdate(&payload); err != nil {
-		return diag.Errorf("failed to update approval policy: %v", err)
+		return ApiFailure("failed to update approval policy", d, err)
 	}
 
 	return nil
 }
 
 func resourceApprovalPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.TemplateDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete approval policy template: %v", err)
@@ -102,8 +102,8 @@ func resourceApprovalPolicyDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func getApprovalPolicyByName(name string, meta any) (*client.ApprovalPolicy, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getApprovalPolicyByName(ctx context.Context, name string, meta any) (*client.ApprovalPolicy, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	approvalPolicies, err := apiClient.ApprovalPolicies(name)
 	if err != nil {
@@ -125,7 +125,7 @@ func getApprovalPolicy(ctx context.Context, id string, meta any) (any, error) {
 	if _, err := uuid.Parse(id); err == nil {
 		tflog.Info(ctx, "Resolving approval policy by id", map[string]any{"id": id})
 
-		template, err := meta.(client.ApiClientInterface).Template(id)
+		template, err := meta.(client.ApiClientInterface).WithContext(ctx).Template(id)
 		if err != nil {
 			return nil, err
 		}
@@ -138,7 +138,7 @@ func getApprovalPolicy(ctx context.Context, id string, meta any) (any, error) {
 	} else {
 		tflog.Info(ctx, "Resolving approval policy by name", map[string]any{"name": id})
 
-		return getApprovalPolicyByName(id, meta)
+		return getApprovalPolicyByName(ctx, id, meta)
 	}
 }
 
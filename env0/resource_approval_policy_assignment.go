@@ -46,7 +46,7 @@ func resourceApprovalPolicyAssignment() *schema.Resource {
 }
 
 func resourceApprovalPolicyAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignment client.ApprovalPolicyAssignment
 	if err := readResourceData(&assignment, d); err != nil {
@@ -72,7 +72,7 @@ func resourceApprovalPolicyAssignmentCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceApprovalPolicyAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignment client.ApprovalPolicyAssignment
 	if err := readResourceData(&assignment, d); err != nil {
@@ -105,7 +105,7 @@ func resourceApprovalPolicyAssignmentRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceApprovalPolicyAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	scope := d.Get("scope").(string)
 	scopeId := d.Get("scope_id").(string)
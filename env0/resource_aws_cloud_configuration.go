@@ -72,7 +72,7 @@ func resourceAwsCloudConfigurationCreate(ctx context.Context, d *schema.Resource
 		return diag.Errorf("rate limit wait error: %v", err)
 	}
 
-	return createCloudConfiguration(d, meta, "AWS")
+	return createCloudConfiguration(ctx, d, meta, "AWS")
 }
 
 func resourceAwsCloudConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -80,5 +80,5 @@ func resourceAwsCloudConfigurationUpdate(ctx context.Context, d *schema.Resource
 		return diag.Errorf("rate limit wait error: %v", err)
 	}
 
-	return updateCloudConfiguration(d, meta, "AWS")
+	return updateCloudConfiguration(ctx, d, meta, "AWS")
 }
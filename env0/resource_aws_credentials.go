@@ -17,6 +17,7 @@ func resourceAwsCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(AWS_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the credentials",
@@ -67,6 +68,8 @@ func resourceAwsCredentials() *schema.Resource {
 }
 
 func resourceAwsCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	_, accessKeyExist := d.GetOk("access_key_id")
 	_, arnExist := d.GetOk("arn")
 
@@ -76,7 +79,7 @@ func resourceAwsCredentialsCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("one of `access_key_id,arn` must be specified")
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value := client.AwsCredentialsValuePayload{}
 	if err := readResourceData(&value, d); err != nil {
@@ -99,7 +102,7 @@ func resourceAwsCredentialsCreate(ctx context.Context, d *schema.ResourceData, m
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create credentials key: %v", err)
+		return ApiFailure("could not create credentials key", d, err)
 	}
 
 	d.SetId(credentials.Id)
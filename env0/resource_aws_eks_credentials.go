@@ -18,6 +18,7 @@ func resourceAwsEksCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(AWS_EKS_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the credentials",
@@ -39,12 +40,14 @@ func resourceAwsEksCredentials() *schema.Resource {
 }
 
 func resourceAwsEksCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	value := client.AwsEksValue{}
 	if err := readResourceData(&value, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	request := client.KubernetesCredentialsCreatePayload{
 		Name:  d.Get("name").(string),
@@ -54,7 +57,7 @@ func resourceAwsEksCredentialsCreate(ctx context.Context, d *schema.ResourceData
 
 	credentials, err := apiClient.KubernetesCredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create credentials: %v", err)
+		return ApiFailure("could not create credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -63,12 +66,14 @@ func resourceAwsEksCredentialsCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAwsEksCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	value := client.AwsEksValue{}
 	if err := readResourceData(&value, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	request := client.KubernetesCredentialsUpdatePayload{
 		Value: value,
@@ -76,7 +81,7 @@ func resourceAwsEksCredentialsUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if _, err := apiClient.KubernetesCredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not create credentials: %v", err)
+		return ApiFailure("could not create credentials", d, err)
 	}
 
 	return nil
@@ -19,6 +19,7 @@ func resourceAwsOidcCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(AWS_OIDC_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the oidc credentials",
@@ -66,7 +67,9 @@ func awsOidcCredentialsGetValue(d *schema.ResourceData) (client.AwsCredentialsVa
 }
 
 func resourceAwsOidcCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := awsOidcCredentialsGetValue(d)
 	if err != nil {
@@ -82,7 +85,7 @@ func resourceAwsOidcCredentialsCreate(ctx context.Context, d *schema.ResourceDat
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create aws oidc credentials: %v", err)
+		return ApiFailure("could not create aws oidc credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -91,7 +94,9 @@ func resourceAwsOidcCredentialsCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAwsOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := awsOidcCredentialsGetValue(d)
 	if err != nil {
@@ -104,7 +109,7 @@ func resourceAwsOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if _, err := apiClient.CredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not update aws oidc credentials: %s %v", d.Id(), err)
+		return ApiFailure(fmt.Sprintf("could not update aws oidc credentials %s", d.Id()), d, err)
 	}
 
 	return nil
@@ -49,9 +49,9 @@ func resourceAzureCloudConfiguration() *schema.Resource {
 }
 
 func resourceAzureCloudConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return createCloudConfiguration(d, meta, "AzureLAW")
+	return createCloudConfiguration(ctx, d, meta, "AzureLAW")
 }
 
 func resourceAzureCloudConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return updateCloudConfiguration(d, meta, "AzureLAW")
+	return updateCloudConfiguration(ctx, d, meta, "AzureLAW")
 }
@@ -17,6 +17,7 @@ func resourceAzureCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(AZURE_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the credentials",
@@ -59,12 +60,14 @@ func resourceAzureCredentials() *schema.Resource {
 }
 
 func resourceAzureCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	value := client.AzureCredentialsValuePayload{}
 	if err := readResourceData(&value, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	requestType := client.AzureServicePrincipalCredentialsType
 
@@ -77,7 +80,7 @@ func resourceAzureCredentialsCreate(ctx context.Context, d *schema.ResourceData,
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create credentials key: %v", err)
+		return ApiFailure("could not create credentials key", d, err)
 	}
 
 	d.SetId(credentials.Id)
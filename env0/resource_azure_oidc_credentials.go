@@ -19,6 +19,7 @@ func resourceAzureOidcCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(AZURE_OIDC_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the oidc credentials",
@@ -67,7 +68,9 @@ func azureOidcCredentialsGetValue(d *schema.ResourceData) (client.AzureCredentia
 }
 
 func resourceAzureOidcCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := azureOidcCredentialsGetValue(d)
 	if err != nil {
@@ -83,7 +86,7 @@ func resourceAzureOidcCredentialsCreate(ctx context.Context, d *schema.ResourceD
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create azure oidc credentials: %v", err)
+		return ApiFailure("could not create azure oidc credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -92,7 +95,9 @@ func resourceAzureOidcCredentialsCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAzureOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := azureOidcCredentialsGetValue(d)
 	if err != nil {
@@ -105,7 +110,7 @@ func resourceAzureOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if _, err := apiClient.CredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not update azure oidc credentials: %s %v", d.Id(), err)
+		return ApiFailure(fmt.Sprintf("could not update azure oidc credentials %s", d.Id()), d, err)
 	}
 
 	return nil
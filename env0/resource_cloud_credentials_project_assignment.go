@@ -40,7 +40,7 @@ func getCredentialIdAndProjectId(d *schema.ResourceData) (string, string) {
 }
 
 func resourceCloudCredentialsProjectAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialId, projectId := getCredentialIdAndProjectId(d)
 
@@ -55,7 +55,7 @@ func resourceCloudCredentialsProjectAssignmentCreate(ctx context.Context, d *sch
 }
 
 func resourceCloudCredentialsProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialId, projectId := getCredentialIdAndProjectId(d)
 
@@ -89,7 +89,7 @@ func getResourceId(credentialId string, projectId string) string {
 }
 
 func resourceCloudCredentialsProjectAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialId, projectId := getCredentialIdAndProjectId(d)
 
@@ -189,11 +189,11 @@ func resourceConfigurationVariableCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	configurationVariable, err := apiClient.ConfigurationVariableCreate(*params)
 	if err != nil {
-		return diag.Errorf("could not create configurationVariable: %v", err)
+		return ApiFailure("could not create configurationVariable", d, err)
 	}
 
 	d.SetId(configurationVariable.Id)
@@ -231,7 +231,7 @@ func getEnum(d *schema.ResourceData, selectedValue string) ([]string, error) {
 }
 
 func resourceConfigurationVariableRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -259,11 +259,11 @@ func resourceConfigurationVariableUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 	if _, err := apiClient.ConfigurationVariableUpdate(client.ConfigurationVariableUpdateParams{Id: id, CommonParams: *params}); err != nil {
-		return diag.Errorf("could not update configurationVariable: %v", err)
+		return ApiFailure("could not update configurationVariable", d, err)
 	}
 
 	return nil
@@ -275,7 +275,7 @@ func resourceConfigurationVariableDelete(ctx context.Context, d *schema.Resource
 		return nil
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -305,7 +305,7 @@ func resourceConfigurationVariableImport(ctx context.Context, d *schema.Resource
 		configurationParams.Scope = "BLUEPRINT"
 	}
 
-	variable, getErr := getConfigurationVariable(configurationParams, meta)
+	variable, getErr := getConfigurationVariable(ctx, configurationParams, meta)
 	if getErr != nil {
 		return nil, errors.New(getErr[0].Summary)
 	}
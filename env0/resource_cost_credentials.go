@@ -132,12 +132,14 @@ func resourceCostCredentials(providerName string) *schema.Resource {
 
 	getResourceCreate := func() func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			meta = withOrganizationId(d, meta)
+
 			payload, err := getPayload(d)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
-			apiClient := meta.(client.ApiClientInterface)
+			apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 			res, err := apiClient.CredentialsCreate(payload)
 			if err != nil {
@@ -152,12 +154,14 @@ func resourceCostCredentials(providerName string) *schema.Resource {
 
 	getResourceUpdate := func() func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			meta = withOrganizationId(d, meta)
+
 			payload, err := getPayload(d)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
-			apiClient := meta.(client.ApiClientInterface)
+			apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 			if _, err := apiClient.CredentialsUpdate(d.Id(), payload); err != nil {
 				return diag.FromErr(err)
@@ -169,6 +173,8 @@ func resourceCostCredentials(providerName string) *schema.Resource {
 
 	resourceSchema := getSchema()
 
+	resourceSchema["organization_id"] = resourceOrganizationIdSchema()
+
 	resourceSchema["name"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Description: "the name for the credentials",
@@ -185,17 +191,24 @@ func resourceCostCredentials(providerName string) *schema.Resource {
 }
 
 func resourceCostCredentialsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
 
-	if _, err := apiClient.CloudCredentials(d.Id()); err != nil {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
+
+	credentials, err := apiClient.CloudCredentials(d.Id())
+	if err != nil {
 		return ResourceGetFailure(ctx, "cost credentials", d, err)
 	}
 
+	d.Set("organization_id", credentials.OrganizationId)
+
 	return nil
 }
 
 func resourceCostCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.CloudCredentialsDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete credentials: %v", err)
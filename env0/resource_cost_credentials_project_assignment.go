@@ -32,7 +32,7 @@ func resourceCostCredentialsProjectAssignment() *schema.Resource {
 }
 
 func resourceCostCredentialsProjectAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialId, projectId := getCredentialIdAndProjectId(d)
 
@@ -47,7 +47,7 @@ func resourceCostCredentialsProjectAssignmentCreate(ctx context.Context, d *sche
 }
 
 func resourceCostdCredentialsProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialId, projectId := getCredentialIdAndProjectId(d)
 
@@ -76,7 +76,7 @@ func resourceCostdCredentialsProjectAssignmentRead(ctx context.Context, d *schem
 }
 
 func resourceCostCredentialsProjectAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	credentialId, projectId := getCredentialIdAndProjectId(d)
 
@@ -25,7 +25,7 @@ func resourceCustomFlow() *schema.Resource {
 }
 
 func resourceCustomFlowCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.CustomFlowCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -38,7 +38,7 @@ func resourceCustomFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	customFlow, err := apiClient.CustomFlowCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create custom flow: %v", err)
+		return ApiFailure("could not create custom flow", d, err)
 	}
 
 	d.SetId(customFlow.Id)
@@ -47,7 +47,7 @@ func resourceCustomFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCustomFlowRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	customFlow, err := apiClient.CustomFlow(d.Id())
 	if err != nil {
@@ -71,7 +71,7 @@ func resourceCustomFlowRead(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourceCustomFlowUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.CustomFlowCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -83,14 +83,14 @@ func resourceCustomFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if _, err := apiClient.CustomFlowUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update custom flow: %v", err)
+		return ApiFailure("could not update custom flow", d, err)
 	}
 
 	return nil
 }
 
 func resourceCustomFlowDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.CustomFlowDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete custom flow: %v", err)
@@ -99,8 +99,8 @@ func resourceCustomFlowDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func getCustomFlowByName(name string, meta any) (*client.CustomFlow, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getCustomFlowByName(ctx context.Context, name string, meta any) (*client.CustomFlow, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	customFlows, err := apiClient.CustomFlows(name)
 	if err != nil {
@@ -122,11 +122,11 @@ func getCustomFlow(ctx context.Context, id string, meta any) (*client.CustomFlow
 	if _, err := uuid.Parse(id); err == nil {
 		tflog.Info(ctx, "Resolving custom flow by id", map[string]any{"id": id})
 
-		return meta.(client.ApiClientInterface).CustomFlow(id)
+		return meta.(client.ApiClientInterface).WithContext(ctx).CustomFlow(id)
 	} else {
 		tflog.Info(ctx, "Resolving custom flow by name", map[string]any{"name": id})
 
-		return getCustomFlowByName(id, meta)
+		return getCustomFlowByName(ctx, id, meta)
 	}
 }
 
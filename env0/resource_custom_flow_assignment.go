@@ -66,7 +66,7 @@ func resourceCustomFlowAssignment() *schema.Resource {
 }
 
 func resourceCustomFlowAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignment client.CustomFlowAssignment
 	if err := readResourceData(&assignment, d); err != nil {
@@ -83,7 +83,7 @@ func resourceCustomFlowAssignmentCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCustomFlowAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignmentFromId, err := getCustomFlowAssignmentFromId(d)
 	if err != nil {
@@ -118,7 +118,7 @@ func resourceCustomFlowAssignmentRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCustomFlowAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignmentFromId, err := getCustomFlowAssignmentFromId(d)
 	if err != nil {
@@ -45,7 +45,7 @@ func resourceCustomRole() *schema.Resource {
 }
 
 func resourceCustomRoleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.RoleCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -54,7 +54,7 @@ func resourceCustomRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	role, err := apiClient.RoleCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create a custom role: %v", err)
+		return ApiFailure("could not create a custom role", d, err)
 	}
 
 	d.SetId(role.Id)
@@ -63,7 +63,7 @@ func resourceCustomRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCustomRoleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	role, err := apiClient.Role(d.Id())
 	if err != nil {
@@ -78,7 +78,7 @@ func resourceCustomRoleRead(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourceCustomRoleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.RoleUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -86,14 +86,14 @@ func resourceCustomRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if _, err := apiClient.RoleUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update custom role: %v", err)
+		return ApiFailure("could not update custom role", d, err)
 	}
 
 	return nil
 }
 
 func resourceCustomRoleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	err := apiClient.RoleDelete(d.Id())
 	if err != nil {
@@ -103,14 +103,14 @@ func resourceCustomRoleDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func getCustomRoleById(id string, meta any) (*client.Role, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getCustomRoleById(ctx context.Context, id string, meta any) (*client.Role, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	return apiClient.Role(id)
 }
 
-func getCustomRoleByName(name string, meta any) (*client.Role, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getCustomRoleByName(ctx context.Context, name string, meta any) (*client.Role, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	roles, err := apiClient.Roles()
 	if err != nil {
@@ -141,11 +141,11 @@ func getCustomRole(ctx context.Context, id string, meta any) (*client.Role, erro
 	if err == nil {
 		tflog.Info(ctx, "Resolving custom role by id", map[string]any{"id": id})
 
-		return getCustomRoleById(id, meta)
+		return getCustomRoleById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving custom role by name", map[string]any{"name": id})
 
-		return getCustomRoleByName(id, meta)
+		return getCustomRoleByName(ctx, id, meta)
 	}
 }
 
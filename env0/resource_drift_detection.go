@@ -30,6 +30,12 @@ func resourceDriftDetection() *schema.Resource {
 
 		Description: "note: instead of using this resource, setting drift detection can be configured directly through the environment resource",
 
+		// Bounds the retries of an environment that isn't available to the scheduling service yet.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:        schema.TypeString,
@@ -60,7 +66,7 @@ func resourceDriftDetection() *schema.Resource {
 }
 
 func resourceEnvironmentDriftRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Id()
 
@@ -84,7 +90,7 @@ func resourceEnvironmentDriftRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceEnvironmentDriftCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 	cron := d.Get("cron").(string)
@@ -98,7 +104,11 @@ func resourceEnvironmentDriftCreateOrUpdate(ctx context.Context, d *schema.Resou
 
 	// The environment may not be immediately available to the scheduling service
 	// right after creation. Retry on transient "Invalid environment id" errors.
-	retryTimeout := 2 * time.Minute
+	retryTimeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		retryTimeout = d.Timeout(schema.TimeoutCreate)
+	}
+
 	if err := retry.RetryContext(ctx, retryTimeout, func() *retry.RetryError {
 		if _, err := apiClient.EnvironmentUpdateDriftDetection(environmentId, payload); err != nil {
 			if failedReqErr, ok := err.(*env0http.FailedResponseError); ok && failedReqErr.BadRequest() && strings.Contains(failedReqErr.Error(), "Invalid environment id") {
@@ -110,7 +120,7 @@ func resourceEnvironmentDriftCreateOrUpdate(ctx context.Context, d *schema.Resou
 
 		return nil
 	}); err != nil {
-		return diag.Errorf("could not create or update environment drift detection: %v", err)
+		return ApiFailure("could not create or update environment drift detection", d, err)
 	}
 
 	d.SetId(environmentId)
@@ -119,7 +129,7 @@ func resourceEnvironmentDriftCreateOrUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceEnvironmentDriftDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.EnvironmentStopDriftDetection(d.Id()); err != nil {
 		return diag.Errorf("could not stop environment drift detection: %v", err)
@@ -2,9 +2,13 @@ package env0
 
 import (
 	"testing"
+	"time"
 
 	"github.com/env0/terraform-provider-env0/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -93,3 +97,18 @@ func TestUnitEnvironmentDriftDetectionResource(t *testing.T) {
 		})
 	})
 }
+
+func TestEnvironmentDriftDetectionTimeouts(t *testing.T) {
+	driftDetection := resourceDriftDetection()
+
+	assert.Equal(t, 2*time.Minute, *driftDetection.Timeouts.Create)
+	assert.Equal(t, 2*time.Minute, *driftDetection.Timeouts.Update)
+
+	timeouts := *driftDetection.Timeouts
+
+	require.NoError(t, timeouts.ConfigDecode(driftDetection, terraform.NewResourceConfigRaw(map[string]any{
+		"timeouts": []any{map[string]any{"create": "10m"}},
+	})))
+	assert.Equal(t, 10*time.Minute, *timeouts.Create)
+	assert.Equal(t, 2*time.Minute, *timeouts.Update)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"regexp"
 	"slices"
 	"strings"
@@ -168,8 +167,17 @@ func resourceEnvironment() *schema.Resource {
 		UpdateContext: resourceEnvironmentUpdate,
 		DeleteContext: resourceEnvironmentDelete,
 
+		CustomizeDiff: customizeEnvironmentDiff,
+
 		Importer: &schema.ResourceImporter{StateContext: resourceEnvironmentImport},
 
+		// Bounds the waits for deployments (see "wait_for_deployment" and "wait_for_destroy").
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -244,6 +252,7 @@ func resourceEnvironment() *schema.Resource {
 				Computed:    true,
 				Optional:    true,
 			},
+			"outputs": environmentOutputsSchema("the outputs of the last deployment, parsed from 'output'. The outputs of a Terragrunt run-all deployment are flattened (one item per module output). The values of sensitive outputs are omitted (use the env0_environment_outputs data source)"),
 			"ttl": {
 				Type:        schema.TypeString,
 				Description: "the date the environment should be destroyed at (iso format). omitting this attribute will result in infinite ttl.",
@@ -392,7 +401,13 @@ func resourceEnvironment() *schema.Resource {
 			},
 			"wait_for_destroy": {
 				Type:        schema.TypeBool,
-				Description: "(Important note: this option is experimental, please report any issues found). During destroy, waits for the environment status to be 'INACTIVE'. Times out after 30 minutes.",
+				Description: "(Important note: this option is experimental, please report any issues found). During destroy, waits for the environment status to be 'INACTIVE'. Times out after the 'delete' timeout (30 minutes by default).",
+				Default:     false,
+				Optional:    true,
+			},
+			"wait_for_deployment": {
+				Type:        schema.TypeBool,
+				Description: "during create and update, waits for the deployment to finish, and refreshes 'output' once it succeeds. Fails if the deployment fails, times out or is cancelled. If the deployment is waiting for approval (Env0 UI), a warning is returned and the deployment isn't waited for. Times out after the 'create' or 'update' timeout (30 minutes by default).",
 				Default:     false,
 				Optional:    true,
 			},
@@ -458,11 +473,7 @@ func setEnvironmentSchema(ctx context.Context, d *schema.ResourceData, environme
 		d.Set("without_template_settings", settings)
 	}
 
-	if len(environment.LatestDeploymentLog.Output) == 0 {
-		d.Set("output", "null")
-	} else {
-		d.Set("output", string(environment.LatestDeploymentLog.Output))
-	}
+	setDeploymentOutput(ctx, d, environment.LatestDeploymentLog.Output)
 
 	//nolint:staticcheck // https://github.com/hashicorp/terraform-plugin-sdk/issues/817
 	if _, exists := d.GetOkExists("approve_plan_automatically"); exists && environment.RequiresApproval != nil {
@@ -589,7 +600,7 @@ func createEnvironmentWithTemplate(d *schema.ResourceData, apiClient client.ApiC
 
 	environment, err := apiClient.EnvironmentCreate(environmentPayload)
 	if err != nil {
-		return client.Environment{}, client.EnvironmentCreate{}, diag.Errorf("could not create environment: %v", err)
+		return client.Environment{}, client.EnvironmentCreate{}, ApiFailure("could not create environment", d, err)
 	}
 
 	return environment, environmentPayload, nil
@@ -616,14 +627,14 @@ func createEnvironmentWithoutTemplate(d *schema.ResourceData, apiClient client.A
 	// setEnvironmentSchema() sets the blueprint id in the resource (under "without_template_settings.0.id").
 	environment, err := apiClient.EnvironmentCreateWithoutTemplate(payload)
 	if err != nil {
-		return client.Environment{}, client.EnvironmentCreate{}, diag.Errorf("could not create environment: %v", err)
+		return client.Environment{}, client.EnvironmentCreate{}, ApiFailure("could not create environment", d, err)
 	}
 
 	return environment, environmentPayload, nil
 }
 
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if d.Get("is_inactive").(bool) {
 		return diag.Errorf("cannot create an inactive environment (remove 'is_inactive' or set it to 'false')")
@@ -662,6 +673,10 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if d.Get("wait_for_deployment").(bool) {
+		return waitForEnvironmentDeployment(ctx, d, apiClient, waitTimeout(d, schema.TimeoutCreate))
+	}
+
 	return nil
 }
 
@@ -683,7 +698,7 @@ func getEnvironmentVariableSetIdsFromApi(d *schema.ResourceData, apiClient clien
 }
 
 func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environment, err := apiClient.Environment(d.Id())
 	if err != nil {
@@ -818,7 +833,7 @@ func mergeSubEnvironmentConfiguration(stateVariables []any, remoteVariables clie
 }
 
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if d.HasChange("project_id") {
 		newProjectId := d.Get("project_id").(string)
@@ -856,8 +871,14 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			if diagErr := updateWithoutDeploy(d, apiClient); diagErr != nil {
 				return diagErr
 			}
-		} else if err := deploy(d, apiClient); err != nil {
-			return err
+		} else {
+			if err := deploy(d, apiClient); err != nil {
+				return err
+			}
+
+			if d.Get("wait_for_deployment").(bool) {
+				return waitForEnvironmentDeployment(ctx, d, apiClient, waitTimeout(d, schema.TimeoutUpdate))
+			}
 		}
 	}
 
@@ -868,7 +889,14 @@ func shouldUpdateTemplate(d *schema.ResourceData) bool {
 	return isTemplateless(d) && d.HasChange("without_template_settings.0")
 }
 
-func shouldDeploy(d *schema.ResourceData) bool {
+// resourceChanges is implemented by *schema.ResourceData and *schema.ResourceDiff.
+type resourceChanges interface {
+	GetOk(key string) (any, bool)
+	HasChange(key string) bool
+	HasChanges(keys ...string) bool
+}
+
+func shouldDeploy(d resourceChanges) bool {
 	if _, ok := d.GetOk("without_template_settings.0"); ok {
 		if d.HasChange("without_template_settings.0.revision") {
 			return true
@@ -878,6 +906,22 @@ func shouldDeploy(d *schema.ResourceData) bool {
 	return d.HasChanges("revision", "configuration", "sub_environment_configuration", "variable_sets", "template_id")
 }
 
+// customizeEnvironmentDiff marks the deployment and its outputs as unknown when the update deploys the environment,
+// so references to them (E.g. another resource that uses an output) are planned to change with the redeploy.
+func customizeEnvironmentDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !shouldDeploy(diff) || diff.Get("prevent_auto_deploy").(bool) {
+		return nil
+	}
+
+	for _, key := range []string{"deployment_id", "output", "outputs"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func shouldUpdate(d *schema.ResourceData) bool {
 	if d.HasChanges("name", "approve_plan_automatically", "deploy_on_push", "run_plan_on_pull_requests", "auto_deploy_by_custom_glob", "auto_deploy_on_path_changes_only", "vcs_commands_alias", "is_remote_backend", "is_inactive", "is_remote_apply_enabled", "vcs_pr_comments_enabled") {
 		return true
@@ -912,7 +956,7 @@ func updateTemplate(d *schema.ResourceData, apiClient client.ApiClientInterface)
 	templateId := d.Get("without_template_settings.0.id").(string)
 
 	if _, err := apiClient.TemplateUpdate(templateId, payload); err != nil {
-		return diag.Errorf("could not update template: %v", err)
+		return ApiFailure("could not update template", d, err)
 	}
 
 	return nil
@@ -929,7 +973,7 @@ func updateDriftDetection(d *schema.ResourceData, apiClient client.ApiClientInte
 			Enabled: true,
 			Cron:    drift_detection_cron.(string),
 		}); err != nil {
-			return diag.Errorf("could not update drift detection: %v", err)
+			return ApiFailure("could not update drift detection", d, err)
 		}
 	}
 
@@ -1010,19 +1054,19 @@ func deploy(d *schema.ResourceData, apiClient client.ApiClientInterface) diag.Di
 func updateWithoutDeploy(d *schema.ResourceData, apiClient client.ApiClientInterface) diag.Diagnostics {
 	if d.HasChange("configuration") {
 		if err := updateEnvironmentConfigurationWithoutDeploy(d, apiClient); err != nil {
-			return diag.Errorf("could not update environment configuration variables: %v", err)
+			return ApiFailure("could not update environment configuration variables", d, err)
 		}
 	}
 
 	if d.HasChange("sub_environment_configuration") {
 		if err := updateSubEnvironmentsConfigurationWithoutDeploy(d, apiClient); err != nil {
-			return diag.Errorf("could not update sub environment configuration variables: %v", err)
+			return ApiFailure("could not update sub environment configuration variables", d, err)
 		}
 	}
 
 	if d.HasChange("variable_sets") {
 		if err := updateVariableSetsWithoutDeploy(d, apiClient); err != nil {
-			return diag.Errorf("could not update variable sets: %v", err)
+			return ApiFailure("could not update variable sets", d, err)
 		}
 	}
 
@@ -1187,7 +1231,7 @@ func update(d *schema.ResourceData, apiClient client.ApiClientInterface) diag.Di
 
 	_, err := apiClient.EnvironmentUpdate(d.Id(), payload)
 	if err != nil {
-		return diag.Errorf("could not update environment: %v", err)
+		return ApiFailure("could not update environment", d, err)
 	}
 
 	return nil
@@ -1199,7 +1243,7 @@ func updateTTL(d *schema.ResourceData, apiClient client.ApiClientInterface) diag
 
 	_, err := apiClient.EnvironmentUpdateTTL(d.Id(), payload)
 	if err != nil {
-		return diag.Errorf("could not update the environment's ttl: %v", err)
+		return ApiFailure("could not update the environment's ttl", d, err)
 	}
 
 	return nil
@@ -1218,7 +1262,7 @@ func getEnvironmentVariableSetIdsFromSchema(d *schema.ResourceData) []string {
 }
 
 func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	markAsArchived := d.Get("removal_strategy").(string) == "mark_as_archived"
 
@@ -1248,7 +1292,7 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.Get("wait_for_destroy").(bool) {
-		if err := waitForEnvironmentDestroy(ctx, apiClient, res.Id); err != nil {
+		if err := waitForEnvironmentDestroy(ctx, apiClient, res.Id, waitTimeout(d, schema.TimeoutDelete)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -1256,58 +1300,106 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func waitForEnvironmentDestroy(ctx context.Context, apiClient client.ApiClientInterface, deploymentId string) error {
-	waitInterval := time.Second * 10
-	timeout := time.Minute * 30
+// Deployment statuses that end a deployment unsuccessfully.
+var failedDeploymentStatuses = []string{"TIMEOUT", "FAILURE", "CANCELLED", "INTERNAL_FAILURE", "ABORTING", "ABORTED", "SKIPPED", "NEVER_DEPLOYED"}
 
-	if os.Getenv("TF_ACC") == "1" { // For acceptance tests reducing interval to 1 second and timeout to 10 seconds.
-		waitInterval = time.Second
-		timeout = time.Second * 10
+// waitForEnvironmentDeployment waits for the deployment of the environment ("deployment_id") to finish, and sets
+// "output" and "outputs" to the output of the deployment once it succeeds.
+func waitForEnvironmentDeployment(ctx context.Context, d *schema.ResourceData, apiClient client.ApiClientInterface, timeout time.Duration) diag.Diagnostics {
+	deploymentId := d.Get("deployment_id").(string)
+
+	deployment, diags := waitForDeployment(ctx, apiClient, deploymentId, timeout)
+	if diags.HasError() || deployment == nil {
+		return diags
 	}
 
-	ticker := time.NewTicker(waitInterval) // When invoked - check the status.
-	timer := time.NewTimer(timeout)        // When invoked - timeout.
-	results := make(chan error)
+	setDeploymentOutput(ctx, d, deployment.Output)
 
-	go func() {
-		for {
-			deployment, err := apiClient.EnvironmentDeploymentLog(deploymentId)
-			if err != nil {
-				results <- fmt.Errorf("failed to get environment deployment '%s': %w", deploymentId, err)
+	return diags
+}
 
-				return
-			}
+// waitForDeployment polls a deployment until it finishes. Returns the deployment if it succeeded, or a warning (and no
+// deployment) if it's waiting for approval, since it may not be approved before the timeout.
+func waitForDeployment(ctx context.Context, apiClient client.ApiClientInterface, deploymentId string, timeout time.Duration) (*client.DeploymentLog, diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-			if slices.Contains([]string{"TIMEOUT", "FAILURE", "CANCELLED", "INTERNAL_FAILURE", "ABORTING", "ABORTED", "SKIPPED", "NEVER_DEPLOYED"}, deployment.Status) {
-				results <- fmt.Errorf("failed to wait for environment destroy to complete, deployment status is: %s", deployment.Status)
+	var deployment *client.DeploymentLog
 
-				return
-			}
+	err := poll(ctx, pollInterval(time.Second*10), func() (bool, error) {
+		var err error
+		if deployment, err = apiClient.EnvironmentDeploymentLog(deploymentId); err != nil {
+			return false, fmt.Errorf("failed to get environment deployment '%s': %w", deploymentId, err)
+		}
 
-			if deployment.Status == "SUCCESS" {
-				results <- nil
+		if deployment.Status == "SUCCESS" || deployment.Status == "WAITING_FOR_USER" || slices.Contains(failedDeploymentStatuses, deployment.Status) {
+			return true, nil
+		}
 
-				return
-			}
+		tflog.Info(ctx, "current deployment status", map[string]any{"deploymentId": deploymentId, "status": deployment.Status})
 
-			tflog.Info(ctx, "current 'destroy' deployment status", map[string]any{"deploymentId": deploymentId, "status": deployment.Status})
+		return false, nil
+	})
 
-			if deployment.Status == "WAITING_FOR_USER" {
-				tflog.Warn(ctx, "waiting for user approval (Env0 UI) to proceed with 'destroy' deployment")
-			}
+	switch {
+	case isTimeout(err) && deployment != nil:
+		return nil, diag.Errorf("timeout! last deployment status was '%s'", deployment.Status)
+	case err != nil && ctx.Err() != nil && deployment != nil:
+		return nil, diag.Errorf("stopped waiting for deployment '%s' (last status was '%s'): %v", deploymentId, deployment.Status, err)
+	case err != nil:
+		return nil, diag.FromErr(err)
+	case slices.Contains(failedDeploymentStatuses, deployment.Status):
+		summary := fmt.Sprintf("deployment '%s' failed, deployment status is: %s", deploymentId, deployment.Status)
+		if len(deployment.Error) > 0 && string(deployment.Error) != "null" {
+			summary += fmt.Sprintf(", error: %s", deployment.Error)
+		}
 
-			select {
-			case <-timer.C:
-				results <- fmt.Errorf("timeout! last 'destroy' deployment status was '%s'", deployment.Status)
+		return nil, diag.Diagnostics{diag.Diagnostic{Severity: diag.Error, Summary: summary}}
+	case deployment.Status == "WAITING_FOR_USER":
+		return nil, diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("deployment '%s' is waiting for user approval (Env0 UI)", deploymentId),
+			Detail:   "the deployment wasn't waited for, 'output' is refreshed once the deployment succeeds and the environment is read again",
+		}}
+	}
 
-				return
-			case <-ticker.C:
-				continue
-			}
+	return deployment, nil
+}
+
+func waitForEnvironmentDestroy(ctx context.Context, apiClient client.ApiClientInterface, deploymentId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var deployment *client.DeploymentLog
+
+	err := poll(ctx, pollInterval(time.Second*10), func() (bool, error) {
+		var err error
+		if deployment, err = apiClient.EnvironmentDeploymentLog(deploymentId); err != nil {
+			return false, fmt.Errorf("failed to get environment deployment '%s': %w", deploymentId, err)
+		}
+
+		if slices.Contains(failedDeploymentStatuses, deployment.Status) {
+			return false, fmt.Errorf("failed to wait for environment destroy to complete, deployment status is: %s", deployment.Status)
+		}
+
+		if deployment.Status == "SUCCESS" {
+			return true, nil
 		}
-	}()
 
-	return <-results
+		tflog.Info(ctx, "current 'destroy' deployment status", map[string]any{"deploymentId": deploymentId, "status": deployment.Status})
+
+		if deployment.Status == "WAITING_FOR_USER" {
+			tflog.Warn(ctx, "waiting for user approval (Env0 UI) to proceed with 'destroy' deployment")
+		}
+
+		return false, nil
+	})
+
+	if isTimeout(err) && deployment != nil {
+		return fmt.Errorf("timeout! last 'destroy' deployment status was '%s'", deployment.Status)
+	}
+
+	return err
 }
 
 func getCreatePayload(d *schema.ResourceData, apiClient client.ApiClientInterface, templateType string) (client.EnvironmentCreate, diag.Diagnostics) {
@@ -1661,8 +1753,8 @@ func typeEqual(variable client.ConfigurationVariable, search client.Configuratio
 		search.Type == nil && *variable.Type == client.ConfigurationVariableTypeEnvironment
 }
 
-func getEnvironmentByName(meta any, name string, projectId string, excludeArchived bool) (client.Environment, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getEnvironmentByName(ctx context.Context, meta any, name string, projectId string, excludeArchived bool) (client.Environment, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentsByName, err := apiClient.EnvironmentsByName(name)
 	if err != nil {
@@ -1694,8 +1786,8 @@ func getEnvironmentByName(meta any, name string, projectId string, excludeArchiv
 	return filteredEnvironments[0], nil
 }
 
-func getEnvironmentById(environmentId string, meta any) (client.Environment, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getEnvironmentById(ctx context.Context, environmentId string, meta any) (client.Environment, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environment, err := apiClient.Environment(environmentId)
 	if err != nil {
@@ -1715,18 +1807,18 @@ func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta
 	_, err := uuid.Parse(id)
 	if err == nil {
 		tflog.Info(ctx, "Resolving environment by id", map[string]any{"id": id})
-		environment, getErr = getEnvironmentById(id, meta)
+		environment, getErr = getEnvironmentById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving environment by name", map[string]any{"name": id})
 
-		environment, getErr = getEnvironmentByName(meta, id, "", false)
+		environment, getErr = getEnvironmentByName(ctx, meta, id, "", false)
 	}
 
 	if getErr != nil {
 		return nil, errors.New(getErr[0].Summary)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	d.SetId(environment.Id)
 
@@ -1777,6 +1869,7 @@ func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("force_destroy", false)
 	d.Set("wait_for_destroy", false)
+	d.Set("wait_for_deployment", false)
 	d.Set("removal_strategy", "destroy")
 
 	d.Set("vcs_pr_comments_enabled", environment.VcsCommandsAlias != "" || environment.VcsPrCommentsEnabled)
@@ -0,0 +1,213 @@
+package env0
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEnvironmentDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentDeploymentCreate,
+		ReadContext:   resourceEnvironmentDeploymentRead,
+		UpdateContext: resourceEnvironmentDeploymentUpdate,
+		DeleteContext: resourceEnvironmentDeploymentDelete,
+
+		Description: "deploys an existing environment. A new deployment is triggered whenever 'triggers', 'revision' or 'configuration' change. Destroying this resource doesn't destroy the environment (or undo the deployment), it only removes it from the state",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:        schema.TypeString,
+				Description: "the id of the environment to deploy",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "arbitrary values that trigger a new deployment when changed",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Description: "the revision to deploy. Defaults to the revision of the latest deployment of the environment",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"configuration": {
+				Type:        schema.TypeList,
+				Description: "configuration variables of the environment to create or override in the deployment. Variables that aren't set are kept as is",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "variable name",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "variable value",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Description:      "variable type (allowed values are: terraform, environment)",
+							Default:          client.ENVIRONMENT,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: NewStringInValidator([]string{client.ENVIRONMENT, client.TERRAFORM}),
+						},
+						"is_sensitive": {
+							Type:        schema.TypeBool,
+							Description: "should the variable value be hidden",
+							Optional:    true,
+							Default:     false,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"wait_for_deployment": {
+				Type:        schema.TypeBool,
+				Description: "waits for the deployment to finish. Fails if the deployment fails, times out or is cancelled. If the deployment is waiting for approval (Env0 UI), a warning is returned and the deployment isn't waited for. Times out after the 'create' timeout (30 minutes by default)",
+				Optional:    true,
+				Default:     false,
+			},
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Description: "the id of the deployment",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "the status of the deployment (E.g. QUEUED, IN_PROGRESS, WAITING_FOR_USER, SUCCESS, FAILURE)",
+				Computed:    true,
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Description: "the deployment output. Returns a json string. It can be either a map of key-value, or an array of (in case of Terragrunt run-all) of moduleName and a map of key-value. Note: if the deployment is still in progress returns 'null'",
+				Computed:    true,
+			},
+			"outputs": environmentOutputsSchema("the outputs of the deployment, parsed from 'output'. The outputs of a Terragrunt run-all deployment are flattened (one item per module output). The values of sensitive outputs are omitted (use the env0_environment_outputs data source)"),
+		},
+	}
+}
+
+func getEnvironmentDeploymentPayload(d *schema.ResourceData, apiClient client.ApiClientInterface) (client.DeployRequest, error) {
+	environmentId := d.Get("environment_id").(string)
+
+	environment, err := apiClient.Environment(environmentId)
+	if err != nil {
+		return client.DeployRequest{}, fmt.Errorf("could not get environment: %w", err)
+	}
+
+	payload := client.DeployRequest{
+		BlueprintId:       environment.BlueprintId,
+		BlueprintRevision: environment.LatestDeploymentLog.BlueprintRevision,
+	}
+
+	if payload.BlueprintId == "" {
+		payload.BlueprintId = environment.LatestDeploymentLog.BlueprintId
+	}
+
+	if revision, ok := d.GetOk("revision"); ok {
+		payload.BlueprintRevision = revision.(string)
+	}
+
+	if configuration, ok := d.GetOk("configuration"); ok {
+		configurationChanges := client.ConfigurationChanges{}
+
+		for _, variable := range configuration.([]any) {
+			variable := variable.(map[string]any)
+			varType, _ := client.GetConfigurationVariableType(variable["type"].(string))
+
+			configurationChanges = append(configurationChanges, client.ConfigurationVariable{
+				Name:        variable["name"].(string),
+				Value:       variable["value"].(string),
+				Scope:       client.ScopeDeployment,
+				Type:        &varType,
+				IsSensitive: new(variable["is_sensitive"].(bool)),
+			})
+		}
+
+		existingVariables, err := apiClient.ConfigurationVariablesByScope(client.ScopeEnvironment, environmentId)
+		if err != nil {
+			return client.DeployRequest{}, fmt.Errorf("could not get environment configuration variables: %w", err)
+		}
+
+		// Existing variables are overridden (and not duplicated). Other existing variables are kept.
+		configurationChanges = linkToExistConfigurationVariables(configurationChanges, existingVariables)
+		payload.ConfigurationChanges = &configurationChanges
+	}
+
+	return payload, nil
+}
+
+func resourceEnvironmentDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
+
+	payload, err := getEnvironmentDeploymentPayload(d, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployResponse, err := apiClient.EnvironmentDeploy(d.Get("environment_id").(string), payload)
+	if err != nil {
+		return ApiFailure("could not deploy environment", d, err)
+	}
+
+	d.SetId(deployResponse.Id)
+	d.Set("deployment_id", deployResponse.Id)
+
+	var diags diag.Diagnostics
+
+	if d.Get("wait_for_deployment").(bool) {
+		// A failed deployment is tainted, so the next apply deploys again.
+		if _, diags = waitForDeployment(ctx, apiClient, deployResponse.Id, waitTimeout(d, schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceEnvironmentDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceEnvironmentDeploymentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
+
+	deployment, err := apiClient.EnvironmentDeploymentLog(d.Id())
+	if err != nil {
+		return ResourceGetFailure(ctx, "environment deployment", d, err)
+	}
+
+	d.Set("deployment_id", d.Id())
+	d.Set("status", deployment.Status)
+
+	setDeploymentOutput(ctx, d, deployment.Output)
+
+	return nil
+}
+
+func resourceEnvironmentDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Only "wait_for_deployment" may be updated in place. It applies to the next deployment.
+	return resourceEnvironmentDeploymentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// A deployment can't be undone, it's only removed from the state.
+	return nil
+}
@@ -0,0 +1,186 @@
+package env0
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnitEnvironmentDeploymentResource(t *testing.T) {
+	t.Parallel()
+
+	resourceType := "env0_environment_deployment"
+	resourceName := "test"
+	accessor := resourceAccessor(resourceType, resourceName)
+
+	environment := client.Environment{
+		Id:          "environment0",
+		BlueprintId: "template0",
+		LatestDeploymentLog: client.DeploymentLog{
+			BlueprintRevision: "main",
+		},
+	}
+
+	deploymentWithStatus := func(id string, status string) *client.DeploymentLog {
+		return &client.DeploymentLog{Id: id, Status: status, Output: []byte(`{"a":"b"}`)}
+	}
+
+	config := func(extra string) string {
+		return fmt.Sprintf(`
+resource "%s" "%s" {
+	environment_id = "%s"
+	%s
+}`, resourceType, resourceName, environment.Id, extra)
+	}
+
+	t.Run("deploy and redeploy when the triggers change", func(t *testing.T) {
+		testCase := resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config: config(`triggers = { version = "1" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(accessor, "id", "deployment0"),
+						resource.TestCheckResourceAttr(accessor, "deployment_id", "deployment0"),
+						resource.TestCheckResourceAttr(accessor, "status", "IN_PROGRESS"),
+						resource.TestCheckResourceAttr(accessor, "triggers.version", "1"),
+					),
+				},
+				{
+					Config: config(`triggers = { version = "2" }`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(accessor, "id", "deployment1"),
+						resource.TestCheckResourceAttr(accessor, "deployment_id", "deployment1"),
+						resource.TestCheckResourceAttr(accessor, "status", "SUCCESS"),
+						resource.TestCheckResourceAttr(accessor, "output", `{"a":"b"}`),
+						resource.TestCheckResourceAttr(accessor, "outputs.0.name", "a"),
+						resource.TestCheckResourceAttr(accessor, "outputs.0.value", "b"),
+					),
+				},
+			},
+		}
+
+		runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
+			payload := client.DeployRequest{BlueprintId: "template0", BlueprintRevision: "main"}
+
+			mock.EXPECT().Environment(environment.Id).Times(2).Return(environment, nil)
+			gomock.InOrder(
+				mock.EXPECT().EnvironmentDeploy(environment.Id, payload).Times(1).Return(client.EnvironmentDeployResponse{Id: "deployment0"}, nil),
+				mock.EXPECT().EnvironmentDeploy(environment.Id, payload).Times(1).Return(client.EnvironmentDeployResponse{Id: "deployment1"}, nil),
+			)
+			mock.EXPECT().EnvironmentDeploymentLog("deployment0").AnyTimes().Return(deploymentWithStatus("deployment0", "IN_PROGRESS"), nil)
+			mock.EXPECT().EnvironmentDeploymentLog("deployment1").AnyTimes().Return(deploymentWithStatus("deployment1", "SUCCESS"), nil)
+		})
+	})
+
+	t.Run("deploy a revision with configuration overrides and wait for it", func(t *testing.T) {
+		testCase := resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config: config(`
+	revision            = "v2"
+	wait_for_deployment = true
+
+	configuration {
+		name  = "region"
+		value = "us-east-1"
+	}`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(accessor, "revision", "v2"),
+						resource.TestCheckResourceAttr(accessor, "status", "SUCCESS"),
+						resource.TestCheckResourceAttr(accessor, "output", `{"a":"b"}`),
+					),
+				},
+			},
+		}
+
+		runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
+			environmentVariableType := client.ConfigurationVariableTypeEnvironment
+
+			mock.EXPECT().Environment(environment.Id).Times(1).Return(environment, nil)
+			mock.EXPECT().ConfigurationVariablesByScope(client.ScopeEnvironment, environment.Id).Times(1).Return(client.ConfigurationChanges{
+				{Id: "variable0", Name: "region", Value: "us-west-2", Type: &environmentVariableType},
+			}, nil)
+			mock.EXPECT().EnvironmentDeploy(environment.Id, client.DeployRequest{
+				BlueprintId:       "template0",
+				BlueprintRevision: "v2",
+				ConfigurationChanges: &client.ConfigurationChanges{
+					{Id: "variable0", Name: "region", Value: "us-east-1", Scope: client.ScopeDeployment, Type: &environmentVariableType, IsSensitive: new(false)},
+				},
+			}).Times(1).Return(client.EnvironmentDeployResponse{Id: "deployment0"}, nil)
+			gomock.InOrder(
+				mock.EXPECT().EnvironmentDeploymentLog("deployment0").Times(1).Return(deploymentWithStatus("deployment0", "IN_PROGRESS"), nil),
+				mock.EXPECT().EnvironmentDeploymentLog("deployment0").AnyTimes().Return(deploymentWithStatus("deployment0", "SUCCESS"), nil),
+			)
+		})
+	})
+
+	t.Run("deployment fails", func(t *testing.T) {
+		failedDeployment := deploymentWithStatus("deployment0", "FAILURE")
+		failedDeployment.Error = []byte(`"plan failed"`)
+
+		testCase := resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config:      config(`wait_for_deployment = true`),
+					ExpectError: regexp.MustCompile(`deployment 'deployment0' failed, deployment status is: FAILURE, error: "plan failed"`),
+				},
+			},
+		}
+
+		runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
+			mock.EXPECT().Environment(environment.Id).Times(1).Return(environment, nil)
+			mock.EXPECT().EnvironmentDeploy(environment.Id, gomock.Any()).Times(1).Return(client.EnvironmentDeployResponse{Id: "deployment0"}, nil)
+			mock.EXPECT().EnvironmentDeploymentLog("deployment0").AnyTimes().Return(failedDeployment, nil)
+		})
+	})
+}
+
+func TestGetEnvironmentDeploymentPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mock := client.NewMockApiClientInterface(ctrl)
+
+	mock.EXPECT().Environment("environment0").Return(client.Environment{
+		Id: "environment0",
+		// Environments created before blueprintId was returned.
+		LatestDeploymentLog: client.DeploymentLog{BlueprintId: "template0", BlueprintRevision: "main"},
+	}, nil)
+
+	terraformVariableType := client.ConfigurationVariableTypeTerraform
+
+	mock.EXPECT().ConfigurationVariablesByScope(client.ScopeEnvironment, "environment0").Return(client.ConfigurationChanges{
+		{Id: "variable0", Name: "size", Value: "small", Type: &terraformVariableType},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, resourceEnvironmentDeployment().Schema, map[string]any{
+		"environment_id": "environment0",
+		"configuration": []any{
+			map[string]any{"name": "size", "value": "large", "type": "terraform"},
+			map[string]any{"name": "TOKEN", "value": "secret", "is_sensitive": true},
+		},
+	})
+
+	payload, err := getEnvironmentDeploymentPayload(d, mock)
+	require.NoError(t, err)
+
+	assert.Equal(t, "template0", payload.BlueprintId)
+	assert.Equal(t, "main", payload.BlueprintRevision)
+	require.NotNil(t, payload.ConfigurationChanges)
+	require.Len(t, *payload.ConfigurationChanges, 2)
+
+	size := (*payload.ConfigurationChanges)[0]
+	assert.Equal(t, "variable0", size.Id, "an existing variable is overridden")
+	assert.Equal(t, "large", size.Value)
+	assert.Equal(t, client.ConfigurationVariableTypeTerraform, *size.Type)
+
+	token := (*payload.ConfigurationChanges)[1]
+	assert.Empty(t, token.Id)
+	assert.Equal(t, client.ConfigurationVariableTypeEnvironment, *token.Type)
+	assert.True(t, *token.IsSensitive)
+}
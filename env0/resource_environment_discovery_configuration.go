@@ -275,7 +275,7 @@ func discoveryValidatePutPayload(putPayload *client.EnvironmentDiscoveryPutPaylo
 }
 
 func resourceEnvironmentDiscoveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var putPayload client.EnvironmentDiscoveryPutPayload
 	if err := readResourceData(&putPayload, d); err != nil {
@@ -355,7 +355,7 @@ func resourceEnvironmentDiscoveryConfigurationPut(ctx context.Context, d *schema
 }
 
 func resourceEnvironmentDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Get("project_id").(string)
 
@@ -408,7 +408,7 @@ func setResourceEnvironmentDiscoveryConfiguration(d *schema.ResourceData, getPay
 }
 
 func resourceEnvironmentDiscoveryConfigurationGet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Get("project_id").(string)
 
@@ -433,7 +433,7 @@ func resourceEnvironmentDiscoveryConfigurationGet(ctx context.Context, d *schema
 }
 
 func resourceEnvironmentDiscoveryConfigurationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Id()
 
@@ -215,7 +215,7 @@ func getEnvironmentOutputCreateParams(d *schema.ResourceData) (*client.Configura
 }
 
 func resourceEnvironmentOutputConfigurationVariableCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	createParams, err := getEnvironmentOutputCreateParams(d)
 	if err != nil {
@@ -224,7 +224,7 @@ func resourceEnvironmentOutputConfigurationVariableCreate(ctx context.Context, d
 
 	configurationVariable, err := apiClient.ConfigurationVariableCreate(*createParams)
 	if err != nil {
-		return diag.Errorf("could not create environment output configuration variable: %v", err)
+		return ApiFailure("could not create environment output configuration variable", d, err)
 	}
 
 	d.SetId(configurationVariable.Id)
@@ -233,7 +233,7 @@ func resourceEnvironmentOutputConfigurationVariableCreate(ctx context.Context, d
 }
 
 func resourceEnvironmentOutputConfigurationVariableRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	variable, err := apiClient.ConfigurationVariablesById(d.Id())
 	if err != nil {
@@ -253,7 +253,7 @@ func resourceEnvironmentOutputConfigurationVariableRead(ctx context.Context, d *
 }
 
 func resourceEnvironmentOutputConfigurationVariableUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	createParams, err := getEnvironmentOutputCreateParams(d)
 	if err != nil {
@@ -262,7 +262,7 @@ func resourceEnvironmentOutputConfigurationVariableUpdate(ctx context.Context, d
 
 	id := d.Id()
 	if _, err := apiClient.ConfigurationVariableUpdate(client.ConfigurationVariableUpdateParams{Id: id, CommonParams: *createParams}); err != nil {
-		return diag.Errorf("could not update environment output configuration variable: %v", err)
+		return ApiFailure("could not update environment output configuration variable", d, err)
 	}
 
 	return nil
@@ -283,7 +283,7 @@ func resourceEnvironmentOutputConfigurationVariableImport(ctx context.Context, d
 		configurationParams.Scope = "BLUEPRINT"
 	}
 
-	variable, getErr := getConfigurationVariable(configurationParams, meta)
+	variable, getErr := getConfigurationVariable(ctx, configurationParams, meta)
 	if getErr != nil {
 		return nil, errors.New(getErr[0].Summary)
 	}
@@ -41,7 +41,7 @@ func resourceEnvironmentScheduling() *schema.Resource {
 }
 
 func resourceEnvironmentSchedulingRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Id()
 
@@ -58,7 +58,7 @@ func resourceEnvironmentSchedulingRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceEnvironmentSchedulingCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 
@@ -77,7 +77,7 @@ func resourceEnvironmentSchedulingCreateOrUpdate(ctx context.Context, d *schema.
 	}
 
 	if _, err := apiClient.EnvironmentSchedulingUpdate(environmentId, payload); err != nil {
-		return diag.Errorf("could not create or update environment scheduling: %v", err)
+		return ApiFailure("could not create or update environment scheduling", d, err)
 	}
 
 	d.SetId(environmentId)
@@ -86,7 +86,7 @@ func resourceEnvironmentSchedulingCreateOrUpdate(ctx context.Context, d *schema.
 }
 
 func resourceEnvironmentSchedulingDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.EnvironmentSchedulingDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete environment scheduling: %v", err)
@@ -53,11 +53,11 @@ func resourceEnvironmentStateAccessCreate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("'allowed_project_ids' should not be set when 'accessible_from_entire_organization' is set to 'true'")
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	remoteStateAccess, err := apiClient.RemoteStateAccessConfigurationCreate(environmentId, payload)
 	if err != nil {
-		return diag.Errorf("could not create a remote state access configation: %v", err)
+		return ApiFailure("could not create a remote state access configation", d, err)
 	}
 
 	d.SetId(remoteStateAccess.EnvironmentId)
@@ -68,7 +68,7 @@ func resourceEnvironmentStateAccessCreate(ctx context.Context, d *schema.Resourc
 func resourceEnvironmentStateAccessRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	environmentId := d.Get("environment_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	remoteStateAccess, err := apiClient.RemoteStateAccessConfiguration(environmentId)
 	if err != nil {
@@ -85,7 +85,7 @@ func resourceEnvironmentStateAccessRead(ctx context.Context, d *schema.ResourceD
 func resourceEnvironmentStateAccessDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	environmentId := d.Get("environment_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.RemoteStateAccessConfigurationDelete(environmentId); err != nil {
 		return diag.Errorf("could not delete remote state access configation: %v", err)
@@ -1,18 +1,24 @@
 package env0
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/env0/terraform-provider-env0/client"
 	"github.com/env0/terraform-provider-env0/client/http"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -1017,6 +1023,107 @@ func TestUnitEnvironmentResource(t *testing.T) {
 			})
 		})
 
+		t.Run("wait for deployment", func(t *testing.T) {
+			templateId := "template-id"
+
+			deploymentLog := client.DeploymentLog{
+				Id:          "id12345_deployment",
+				BlueprintId: templateId,
+			}
+
+			environment := client.Environment{
+				Id:                    uuid.New().String(),
+				Name:                  "name",
+				ProjectId:             "project-id",
+				LatestDeploymentLogId: deploymentLog.Id,
+				LatestDeploymentLog:   deploymentLog,
+			}
+
+			environmentCreate := client.EnvironmentCreate{
+				Name:      environment.Name,
+				ProjectId: environment.ProjectId,
+
+				DeployRequest: &client.DeployRequest{
+					BlueprintId: templateId,
+				},
+			}
+
+			deploymentWithStatus := func(status string) *client.DeploymentLog {
+				newDeployment := deploymentLog
+				newDeployment.Status = status
+
+				return &newDeployment
+			}
+
+			config := resourceConfigCreate(resourceType, resourceName, map[string]any{
+				"name":                environment.Name,
+				"project_id":          environment.ProjectId,
+				"template_id":         templateId,
+				"wait_for_deployment": true,
+				"force_destroy":       true,
+			})
+
+			t.Run("succeeds", func(t *testing.T) {
+				succeededDeployment := deploymentWithStatus("SUCCESS")
+				succeededDeployment.Output = []byte(`{"a":"b"}`)
+
+				deployedEnvironment := environment
+				deployedEnvironment.LatestDeploymentLog = *succeededDeployment
+
+				testCase := resource.TestCase{
+					Steps: []resource.TestStep{
+						{
+							Config: config,
+							Check: resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr(accessor, "id", environment.Id),
+								resource.TestCheckResourceAttr(accessor, "deployment_id", deploymentLog.Id),
+								resource.TestCheckResourceAttr(accessor, "output", `{"a":"b"}`),
+							),
+						},
+					},
+				}
+
+				runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
+					gomock.InOrder(
+						mock.EXPECT().Template(templateId).Times(1).Return(template, nil),
+						mock.EXPECT().EnvironmentCreate(environmentCreate).Times(1).Return(environment, nil),
+						mock.EXPECT().EnvironmentDeploymentLog(deploymentLog.Id).Times(1).Return(deploymentWithStatus("IN_PROGRESS"), nil),
+						mock.EXPECT().EnvironmentDeploymentLog(deploymentLog.Id).Times(1).Return(succeededDeployment, nil),
+						mock.EXPECT().Environment(environment.Id).Times(1).Return(deployedEnvironment, nil),
+						mock.EXPECT().ConfigurationVariablesByScope(client.ScopeEnvironment, environment.Id).Times(1).Return(client.ConfigurationChanges{}, nil),
+						mock.EXPECT().ConfigurationSetsAssignments("ENVIRONMENT", environment.Id).Times(1).Return(nil, nil),
+						mock.EXPECT().EnvironmentDestroy(environment.Id).Times(1).Return(&client.EnvironmentDestroyResponse{}, nil),
+					)
+				})
+			})
+
+			t.Run("deployment fails", func(t *testing.T) {
+				failedDeployment := deploymentWithStatus("FAILURE")
+				failedDeployment.Error = []byte(`{"message":"apply failed"}`)
+
+				testCase := resource.TestCase{
+					Steps: []resource.TestStep{
+						{
+							Config:      config,
+							ExpectError: regexp.MustCompile(`deployment 'id12345_deployment' failed, deployment status is: FAILURE, error: {"message":"apply failed"}`),
+						},
+					},
+				}
+
+				runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
+					gomock.InOrder(
+						mock.EXPECT().Template(templateId).Times(1).Return(template, nil),
+						mock.EXPECT().EnvironmentCreate(environmentCreate).Times(1).Return(environment, nil),
+						mock.EXPECT().EnvironmentDeploymentLog(deploymentLog.Id).Times(1).Return(failedDeployment, nil),
+					)
+					mock.EXPECT().Environment(environment.Id).AnyTimes().Return(environment, nil)
+					mock.EXPECT().ConfigurationVariablesByScope(client.ScopeEnvironment, environment.Id).AnyTimes().Return(client.ConfigurationChanges{}, nil)
+					mock.EXPECT().ConfigurationSetsAssignments("ENVIRONMENT", environment.Id).AnyTimes().Return(nil, nil)
+					mock.EXPECT().EnvironmentDestroy(environment.Id).Times(1).Return(&client.EnvironmentDestroyResponse{}, nil)
+				})
+			})
+		})
+
 		t.Run("Mark as archived", func(t *testing.T) {
 			environment := client.Environment{
 				Id:        uuid.New().String(),
@@ -4344,3 +4451,144 @@ func TestUnitEnvironmentIsRequiredDeprecated(t *testing.T) {
 		})
 	})
 }
+
+func TestWaitForDeployment(t *testing.T) {
+	t.Setenv("TF_ACC", "1")
+
+	deploymentId := "deployment0"
+
+	deploymentWithStatus := func(status string) *client.DeploymentLog {
+		return &client.DeploymentLog{Id: deploymentId, Status: status, Output: []byte(`{"a":"b"}`)}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := client.NewMockApiClientInterface(ctrl)
+
+		gomock.InOrder(
+			mock.EXPECT().EnvironmentDeploymentLog(deploymentId).Return(deploymentWithStatus("QUEUED"), nil),
+			mock.EXPECT().EnvironmentDeploymentLog(deploymentId).Return(deploymentWithStatus("SUCCESS"), nil),
+		)
+
+		deployment, diags := waitForDeployment(context.Background(), mock, deploymentId, time.Minute)
+		require.Empty(t, diags)
+		assert.JSONEq(t, `{"a":"b"}`, string(deployment.Output))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := client.NewMockApiClientInterface(ctrl)
+
+		for _, status := range []string{"FAILURE", "TIMEOUT", "CANCELLED"} {
+			mock.EXPECT().EnvironmentDeploymentLog(deploymentId).Return(deploymentWithStatus(status), nil)
+
+			deployment, diags := waitForDeployment(context.Background(), mock, deploymentId, time.Minute)
+			assert.Nil(t, deployment)
+			require.True(t, diags.HasError())
+			assert.Equal(t, "deployment 'deployment0' failed, deployment status is: "+status, diags[0].Summary)
+		}
+	})
+
+	t.Run("waiting for user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := client.NewMockApiClientInterface(ctrl)
+
+		mock.EXPECT().EnvironmentDeploymentLog(deploymentId).Return(deploymentWithStatus("WAITING_FOR_USER"), nil)
+
+		deployment, diags := waitForDeployment(context.Background(), mock, deploymentId, time.Minute)
+		assert.Nil(t, deployment)
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Summary, "waiting for user approval")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := client.NewMockApiClientInterface(ctrl)
+
+		mock.EXPECT().EnvironmentDeploymentLog(deploymentId).Return(deploymentWithStatus("IN_PROGRESS"), nil)
+
+		deployment, diags := waitForDeployment(context.Background(), mock, deploymentId, time.Millisecond*50)
+		assert.Nil(t, deployment)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "timeout! last deployment status was 'IN_PROGRESS'", diags[0].Summary)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := client.NewMockApiClientInterface(ctrl)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mock.EXPECT().EnvironmentDeploymentLog(deploymentId).DoAndReturn(func(string) (*client.DeploymentLog, error) {
+			cancel()
+
+			return deploymentWithStatus("IN_PROGRESS"), nil
+		})
+
+		deployment, diags := waitForDeployment(ctx, mock, deploymentId, time.Minute)
+		assert.Nil(t, deployment)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "last status was 'IN_PROGRESS'")
+	})
+}
+
+func TestEnvironmentRedeployDiff(t *testing.T) {
+	// The state of a deployed environment, with outputs that are referenced by other resources.
+	state := &terraform.InstanceState{
+		ID: "env0",
+		Attributes: map[string]string{
+			"id":                  "env0",
+			"name":                "env",
+			"project_id":          "p",
+			"template_id":         "t",
+			"revision":            "v1",
+			"deployment_id":       "deployment0",
+			"output":              `{"url":{"value":"https://v1.example.com","type":"string"}}`,
+			"outputs.#":           "1",
+			"outputs.0.name":      "url",
+			"outputs.0.module":    "",
+			"outputs.0.key":       "url",
+			"outputs.0.type":      "string",
+			"outputs.0.sensitive": "false",
+			"outputs.0.value":     "https://v1.example.com",
+		},
+	}
+
+	diff := func(t *testing.T, config map[string]any) *terraform.InstanceDiff {
+		t.Helper()
+
+		instanceDiff, err := resourceEnvironment().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+
+		return instanceDiff
+	}
+
+	t.Run("redeploy", func(t *testing.T) {
+		instanceDiff := diff(t, map[string]any{"name": "env", "project_id": "p", "template_id": "t", "revision": "v2"})
+
+		// A reference to an output (E.g. env0_environment.env.outputs[0].value) is unknown until the redeploy is applied,
+		// so the resources that use it are planned to change with it.
+		for _, key := range []string{"deployment_id", "output", "outputs.#"} {
+			if assert.Contains(t, instanceDiff.Attributes, key) {
+				assert.True(t, instanceDiff.Attributes[key].NewComputed, key)
+			}
+		}
+	})
+
+	t.Run("prevent auto deploy", func(t *testing.T) {
+		instanceDiff := diff(t, map[string]any{"name": "env", "project_id": "p", "template_id": "t", "revision": "v2", "prevent_auto_deploy": true})
+
+		for _, key := range []string{"deployment_id", "output", "outputs.#"} {
+			assert.NotContains(t, instanceDiff.Attributes, key)
+		}
+	})
+
+	t.Run("no redeploy", func(t *testing.T) {
+		instanceDiff := diff(t, map[string]any{"name": "renamed", "project_id": "p", "template_id": "t", "revision": "v1"})
+
+		for _, key := range []string{"deployment_id", "output", "outputs.#"} {
+			assert.NotContains(t, instanceDiff.Attributes, key)
+		}
+	})
+}
@@ -45,9 +45,9 @@ func resourceGcpCloudConfiguration() *schema.Resource {
 }
 
 func resourceGcpCloudConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return createCloudConfiguration(d, meta, "GCP")
+	return createCloudConfiguration(ctx, d, meta, "GCP")
 }
 
 func resourceGcpCloudConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return updateCloudConfiguration(d, meta, "GCP")
+	return updateCloudConfiguration(ctx, d, meta, "GCP")
 }
@@ -17,6 +17,7 @@ func resourceGcpCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(GCP_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the credentials",
@@ -48,12 +49,14 @@ func resourceGcpCredentials() *schema.Resource {
 }
 
 func resourceGcpCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	value := client.GcpCredentialsValuePayload{}
 	if err := readResourceData(&value, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	requestType := client.GcpServiceAccountCredentialsType
 
@@ -66,7 +69,7 @@ func resourceGcpCredentialsCreate(ctx context.Context, d *schema.ResourceData, m
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create credentials key: %v", err)
+		return ApiFailure("could not create credentials key", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -19,6 +19,7 @@ func resourceGcpOidcCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(GCP_OIDC_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the oidc credentials",
@@ -57,7 +58,9 @@ func gcpOidcCredentialsGetValue(d *schema.ResourceData) (client.GcpCredentialsVa
 }
 
 func resourceGcpOidcCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := gcpOidcCredentialsGetValue(d)
 	if err != nil {
@@ -73,7 +76,7 @@ func resourceGcpOidcCredentialsCreate(ctx context.Context, d *schema.ResourceDat
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create gcp oidc credentials: %v", err)
+		return ApiFailure("could not create gcp oidc credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -82,7 +85,9 @@ func resourceGcpOidcCredentialsCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGcpOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := gcpOidcCredentialsGetValue(d)
 	if err != nil {
@@ -95,7 +100,7 @@ func resourceGcpOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if _, err := apiClient.CredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not update gcp oidc credentials: %s %v", d.Id(), err)
+		return ApiFailure(fmt.Sprintf("could not update gcp oidc credentials %s", d.Id()), d, err)
 	}
 
 	return nil
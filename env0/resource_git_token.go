@@ -20,6 +20,7 @@ func resourceGitToken() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceGitTokenImport},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "the git token name",
@@ -38,7 +39,9 @@ func resourceGitToken() *schema.Resource {
 }
 
 func resourceGitTokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.GitTokenCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -47,7 +50,7 @@ func resourceGitTokenCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	gitToken, err := apiClient.GitTokenCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create git token: %v", err)
+		return ApiFailure("could not create git token", d, err)
 	}
 
 	d.SetId(gitToken.Id)
@@ -56,7 +59,9 @@ func resourceGitTokenCreate(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourceGitTokenRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	gitToken, err := apiClient.GitToken(d.Id())
 	if err != nil {
@@ -71,7 +76,9 @@ func resourceGitTokenRead(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceGitTokenDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.GitTokenDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete git token: %v", err)
@@ -80,8 +87,8 @@ func resourceGitTokenDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return nil
 }
 
-func getGitTokenByName(name string, meta any) (*client.GitToken, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getGitTokenByName(ctx context.Context, name string, meta any) (*client.GitToken, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	gitTokens, err := apiClient.GitTokens()
 	if err != nil {
@@ -112,15 +119,17 @@ func getGitToken(ctx context.Context, id string, meta any) (*client.GitToken, er
 	if err == nil {
 		tflog.Info(ctx, "Resolving git token by id", map[string]any{"id": id})
 
-		return meta.(client.ApiClientInterface).GitToken(id)
+		return meta.(client.ApiClientInterface).WithContext(ctx).GitToken(id)
 	} else {
 		tflog.Info(ctx, "Resolving git token by name", map[string]any{"name": id})
 
-		return getGitTokenByName(id, meta)
+		return getGitTokenByName(ctx, id, meta)
 	}
 }
 
 func resourceGitTokenImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	gitToken, err := getGitToken(ctx, d.Id(), meta)
 	if err != nil {
 		return nil, err
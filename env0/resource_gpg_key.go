@@ -44,7 +44,7 @@ func resourceGpgKey() *schema.Resource {
 }
 
 func resourceGpgKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.GpgKeyCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -53,7 +53,7 @@ func resourceGpgKeyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	gpgKey, err := apiClient.GpgKeyCreate(&payload)
 	if err != nil {
-		return diag.Errorf("could not create gpg key: %v", err)
+		return ApiFailure("could not create gpg key", d, err)
 	}
 
 	d.SetId(gpgKey.Id)
@@ -62,7 +62,7 @@ func resourceGpgKeyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceGpgKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	gpgKey, err := getGpgKeyById(d.Id(), meta)
+	gpgKey, err := getGpgKeyById(ctx, d.Id(), meta)
 	if err != nil {
 		return ResourceGetFailure(ctx, "gpg key", d, err)
 	}
@@ -75,7 +75,7 @@ func resourceGpgKeyRead(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func resourceGpgKeyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.GpgKeyDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete gpg key: %v", err)
@@ -84,8 +84,8 @@ func resourceGpgKeyDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return nil
 }
 
-func getGpgKeyById(id string, meta any) (*client.GpgKey, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getGpgKeyById(ctx context.Context, id string, meta any) (*client.GpgKey, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	gpgKeys, err := apiClient.GpgKeys()
 	if err != nil {
@@ -101,8 +101,8 @@ func getGpgKeyById(id string, meta any) (*client.GpgKey, error) {
 	return nil, &client.NotFoundError{}
 }
 
-func getGpgKeyByName(name string, meta any) (*client.GpgKey, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getGpgKeyByName(ctx context.Context, name string, meta any) (*client.GpgKey, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	gpgKeys, err := apiClient.GpgKeys()
 	if err != nil {
@@ -133,11 +133,11 @@ func getGpgKey(ctx context.Context, idOrName string, meta any) (*client.GpgKey,
 	if err == nil {
 		tflog.Info(ctx, "Resolving gpg key by id", map[string]any{"id": idOrName})
 
-		return getGpgKeyById(idOrName, meta)
+		return getGpgKeyById(ctx, idOrName, meta)
 	} else {
 		tflog.Info(ctx, "Resolving gpg key by name", map[string]any{"name": idOrName})
 
-		return getGpgKeyByName(idOrName, meta)
+		return getGpgKeyByName(ctx, idOrName, meta)
 	}
 }
 
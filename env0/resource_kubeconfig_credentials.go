@@ -18,6 +18,7 @@ func resourceKubeconfigCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(KUBECONFIG_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the credentials",
@@ -34,12 +35,14 @@ func resourceKubeconfigCredentials() *schema.Resource {
 }
 
 func resourceKubeconfigCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	value := client.KubeconfigFileValue{}
 	if err := readResourceData(&value, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	request := client.KubernetesCredentialsCreatePayload{
 		Name:  d.Get("name").(string),
@@ -49,7 +52,7 @@ func resourceKubeconfigCredentialsCreate(ctx context.Context, d *schema.Resource
 
 	credentials, err := apiClient.KubernetesCredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create credentials: %v", err)
+		return ApiFailure("could not create credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -58,12 +61,14 @@ func resourceKubeconfigCredentialsCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceKubeconfigCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	meta = withOrganizationId(d, meta)
+
 	value := client.KubeconfigFileValue{}
 	if err := readResourceData(&value, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	request := client.KubernetesCredentialsUpdatePayload{
 		Value: value,
@@ -71,7 +76,7 @@ func resourceKubeconfigCredentialsUpdate(ctx context.Context, d *schema.Resource
 	}
 
 	if _, err := apiClient.KubernetesCredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not create credentials: %v", err)
+		return ApiFailure("could not create credentials", d, err)
 	}
 
 	return nil
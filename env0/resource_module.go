@@ -177,7 +177,7 @@ func resourceModule() *schema.Resource {
 }
 
 func resourceModuleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ModuleCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -194,7 +194,7 @@ func resourceModuleCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	module, err := apiClient.ModuleCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create module: %v", err)
+		return ApiFailure("could not create module", d, err)
 	}
 
 	d.SetId(module.Id)
@@ -203,7 +203,7 @@ func resourceModuleCreate(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceModuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	module, err := apiClient.Module(d.Id())
 	if err != nil {
@@ -235,7 +235,7 @@ func resourceModuleRead(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func resourceModuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ModuleUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -251,14 +251,14 @@ func resourceModuleUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if _, err := apiClient.ModuleUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update module: %v", err)
+		return ApiFailure("could not update module", d, err)
 	}
 
 	return nil
 }
 
 func resourceModuleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.ModuleDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete module: %v", err)
@@ -267,8 +267,8 @@ func resourceModuleDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return nil
 }
 
-func getModuleByName(name string, meta any) (*client.Module, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getModuleByName(ctx context.Context, name string, meta any) (*client.Module, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	modules, err := apiClient.Modules()
 	if err != nil {
@@ -299,11 +299,11 @@ func getModule(ctx context.Context, id string, meta any) (*client.Module, error)
 	if err == nil {
 		tflog.Info(ctx, "Resolving module by id", map[string]any{"id": id})
 
-		return meta.(client.ApiClientInterface).Module(id)
+		return meta.(client.ApiClientInterface).WithContext(ctx).Module(id)
 	} else {
 		tflog.Info(ctx, "Resolving module by name", map[string]any{"name": id})
 
-		return getModuleByName(id, meta)
+		return getModuleByName(ctx, id, meta)
 	}
 }
 
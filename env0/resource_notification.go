@@ -23,6 +23,7 @@ func resourceNotification() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceNotificationImport},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:             schema.TypeString,
 				Description:      "the name of the notification",
@@ -58,25 +59,23 @@ func resourceNotification() *schema.Resource {
 	}
 }
 
-func getNotificationById(id string, meta any) (*client.Notification, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getNotificationById(ctx context.Context, id string, meta any) (*client.Notification, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
-	notifications, err := apiClient.Notifications()
+	notification, found, err := client.FindFirst(apiClient.NotificationsIter(), func(candidate client.Notification) bool { return candidate.Id == id })
 	if err != nil {
 		return nil, err
 	}
 
-	for _, notification := range notifications {
-		if notification.Id == id {
-			return &notification, nil
-		}
+	if !found {
+		return nil, ErrNotFound
 	}
 
-	return nil, ErrNotFound
+	return &notification, nil
 }
 
-func getNotificationByName(name string, meta any) (*client.Notification, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getNotificationByName(ctx context.Context, name string, meta any) (*client.Notification, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	notifications, err := apiClient.Notifications()
 	if err != nil {
@@ -103,7 +102,9 @@ func getNotificationByName(name string, meta any) (*client.Notification, error)
 }
 
 func resourceNotificationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.NotificationCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -112,7 +113,7 @@ func resourceNotificationCreate(ctx context.Context, d *schema.ResourceData, met
 
 	notification, err := apiClient.NotificationCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create notification: %v", err)
+		return ApiFailure("could not create notification", d, err)
 	}
 
 	d.SetId(notification.Id)
@@ -121,7 +122,9 @@ func resourceNotificationCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceNotificationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	notification, err := getNotificationById(d.Id(), meta)
+	meta = withOrganizationId(d, meta)
+
+	notification, err := getNotificationById(ctx, d.Id(), meta)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			if notification == nil {
@@ -143,7 +146,9 @@ func resourceNotificationRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	payload := client.NotificationUpdatePayload{}
 
@@ -167,14 +172,16 @@ func resourceNotificationUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if _, err := apiClient.NotificationUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update notification: %v", err)
+		return ApiFailure("could not update notification", d, err)
 	}
 
 	return nil
 }
 
 func resourceNotificationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.NotificationDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete notification: %v", err)
@@ -188,15 +195,17 @@ func getNotification(ctx context.Context, id string, meta any) (*client.Notifica
 	if err == nil {
 		tflog.Info(ctx, "Resolving notification by id", map[string]any{"id": id})
 
-		return getNotificationById(id, meta)
+		return getNotificationById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving notification by name", map[string]any{"name	": id})
 
-		return getNotificationByName(id, meta)
+		return getNotificationByName(ctx, id, meta)
 	}
 }
 
 func resourceNotificationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	notification, err := getNotification(ctx, d.Id(), meta)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
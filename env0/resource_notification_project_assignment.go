@@ -72,11 +72,11 @@ func resourceNotificationProjectAssignmentCreateOrUpdate(ctx context.Context, d
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignment, err := apiClient.NotificationProjectAssignmentUpdate(projectId, endpointId, payload)
 	if err != nil {
-		return diag.Errorf("could not create or update notification project assignment: %v", err)
+		return ApiFailure("could not create or update notification project assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -84,11 +84,11 @@ func resourceNotificationProjectAssignmentCreateOrUpdate(ctx context.Context, d
 	return nil
 }
 
-func getNotificationProjectAssignment(d *schema.ResourceData, meta any) (*client.NotificationProjectAssignment, error) {
+func getNotificationProjectAssignment(ctx context.Context, d *schema.ResourceData, meta any) (*client.NotificationProjectAssignment, error) {
 	projectId := d.Get("project_id").(string)
 	endpointId := d.Get("notification_endpoint_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignments, err := apiClient.NotificationProjectAssignments(projectId)
 	if err != nil {
@@ -105,7 +105,7 @@ func getNotificationProjectAssignment(d *schema.ResourceData, meta any) (*client
 }
 
 func resourceNotificationProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	assignment, err := getNotificationProjectAssignment(d, meta)
+	assignment, err := getNotificationProjectAssignment(ctx, d, meta)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			// Notification endpoint not found.
@@ -133,7 +133,7 @@ func resourceNotificationProjectAssignmentDelete(ctx context.Context, d *schema.
 		EventNames: []string{},
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if _, err := apiClient.NotificationProjectAssignmentUpdate(projectId, endpointId, payload); err != nil {
 		return diag.Errorf("could not delete notification project assignment: %v", err)
@@ -107,8 +107,8 @@ func TestUnitNotificationResource(t *testing.T) {
 			}).Times(1).Return(&updatedNotification, nil)
 
 			gomock.InOrder(
-				mock.EXPECT().Notifications().Times(2).Return([]client.Notification{notification}, nil),
-				mock.EXPECT().Notifications().Times(1).Return([]client.Notification{updatedNotification}, nil),
+				mock.EXPECT().NotificationsIter().Times(2).Return(seqOf(notification)),
+				mock.EXPECT().NotificationsIter().Times(1).Return(seqOf(updatedNotification)),
 			)
 
 			mock.EXPECT().NotificationDelete(notification.Id).Times(1)
@@ -223,7 +223,7 @@ func TestUnitNotificationResource(t *testing.T) {
 				Value: updatedNotification.Value,
 			}).Times(1).Return(nil, errors.New("error"))
 
-			mock.EXPECT().Notifications().Times(2).Return([]client.Notification{notification}, nil)
+			mock.EXPECT().NotificationsIter().Times(2).Return(seqOf(notification))
 			mock.EXPECT().NotificationDelete(notification.Id).Times(1)
 		})
 	})
@@ -253,7 +253,8 @@ func TestUnitNotificationResource(t *testing.T) {
 				Type:  notification.Type,
 				Value: notification.Value,
 			}).Times(1).Return(&notification, nil)
-			mock.EXPECT().Notifications().Times(3).Return([]client.Notification{notification}, nil)
+			mock.EXPECT().Notifications().Times(1).Return([]client.Notification{notification}, nil)
+			mock.EXPECT().NotificationsIter().Times(2).Return(seqOf(notification))
 			mock.EXPECT().NotificationDelete(notification.Id).Times(1)
 		})
 	})
@@ -283,7 +284,7 @@ func TestUnitNotificationResource(t *testing.T) {
 				Type:  notificationById.Type,
 				Value: notificationById.Value,
 			}).Times(1).Return(&notificationById, nil)
-			mock.EXPECT().Notifications().Times(3).Return([]client.Notification{notificationById}, nil)
+			mock.EXPECT().NotificationsIter().Times(3).Return(seqOf(notificationById))
 			mock.EXPECT().NotificationDelete(notificationById.Id).Times(1)
 		})
 	})
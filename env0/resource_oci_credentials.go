@@ -19,6 +19,7 @@ func resourceOciCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(OCI_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the oci credentials",
@@ -71,7 +72,9 @@ func ociCredentialsGetValue(d *schema.ResourceData) (client.OciCredentialsValueP
 }
 
 func resourceOciCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := ociCredentialsGetValue(d)
 	if err != nil {
@@ -87,7 +90,7 @@ func resourceOciCredentialsCreate(ctx context.Context, d *schema.ResourceData, m
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create oci credentials: %v", err)
+		return ApiFailure("could not create oci credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -96,7 +99,9 @@ func resourceOciCredentialsCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceOciCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := ociCredentialsGetValue(d)
 	if err != nil {
@@ -109,7 +114,7 @@ func resourceOciCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if _, err := apiClient.CredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not update oci credentials: %s %v", d.Id(), err)
+		return ApiFailure(fmt.Sprintf("could not update oci credentials %s", d.Id()), d, err)
 	}
 
 	return nil
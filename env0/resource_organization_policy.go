@@ -62,7 +62,7 @@ func resourceOrganizationPolicy() *schema.Resource {
 }
 
 func resourceOrganizationPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organization, err := apiClient.Organization()
 	if err != nil {
@@ -96,7 +96,7 @@ func validateTtl(defaultTtl *string, maxTtl *string) error {
 }
 
 func resourceOrganizationPolicyCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.OrganizationPolicyUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -109,7 +109,7 @@ func resourceOrganizationPolicyCreateOrUpdate(ctx context.Context, d *schema.Res
 
 	organization, err := apiClient.OrganizationPolicyUpdate((payload))
 	if err != nil {
-		return diag.Errorf("could not update organization policy: %v", err)
+		return ApiFailure("could not update organization policy", d, err)
 	}
 
 	d.SetId(organization.Id)
@@ -118,12 +118,12 @@ func resourceOrganizationPolicyCreateOrUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceOrganizationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	// In cases of a "DELETE", update the organization policy to default values.
 	var payload client.OrganizationPolicyUpdatePayload
 	if _, err := apiClient.OrganizationPolicyUpdate(payload); err != nil {
-		return diag.Errorf("could not update organization policy to default values: %v", err)
+		return ApiFailure("could not update organization policy to default values", d, err)
 	}
 
 	return nil
@@ -3,7 +3,6 @@ package env0
 import (
 	"context"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/env0/terraform-provider-env0/client"
@@ -13,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-const PROJECT_DESTROY_TOTAL_WAIT_TIME = time.Minute * 10
 const PROJECT_DESTROY_WAIT_INTERVAL = time.Second * 10
 
 type ActiveEnvironmentError struct {
@@ -31,10 +29,19 @@ func resourceProject() *schema.Resource {
 		ReadContext:   resourceProjectRead,
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
+		CustomizeDiff: customizeTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{StateContext: resourceProjectImport},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			// Bounds the wait for the environments to be destroyed (see "wait").
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:             schema.TypeString,
 				Description:      "name to give the project",
@@ -59,7 +66,7 @@ func resourceProject() *schema.Resource {
 			},
 			"wait": {
 				Type:        schema.TypeBool,
-				Description: "Wait for all environments to be destroyed before destroying this project (up to 10 minutes)",
+				Description: "Wait for all environments to be destroyed before destroying this project (up to the 'delete' timeout, 10 minutes by default)",
 				Optional:    true,
 				Default:     false,
 			},
@@ -76,21 +83,26 @@ func resourceProject() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ProjectCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
+	payload.Tags = mergeDefaultTags(payload.Tags, defaultTags(meta))
+
 	project, err := apiClient.ProjectCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create project: %v", err)
+		return ApiFailure("could not create project", d, err)
 	}
 
 	d.SetId(project.Id)
@@ -99,22 +111,32 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	project, err := apiClient.Project(d.Id())
 	if err != nil {
 		return ResourceGetFailure(ctx, "project", d, err)
 	}
 
+	configuredTags := toTags(d.Get("tags"))
+
 	if err := writeResourceData(&project, d); err != nil {
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
+	if err := writeTags(d, project.Tags, configuredTags, defaultTags(meta)); err != nil {
+		return diag.Errorf("schema resource data serialization failed: %v", err)
+	}
+
 	return nil
 }
 
 func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -132,20 +154,22 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
+	payload.Tags = mergeDefaultTags(payload.Tags, defaultTags(meta))
+
 	if _, err := apiClient.ProjectUpdate(id, payload); err != nil {
-		return diag.Errorf("could not update project: %v", err)
+		return ApiFailure("could not update project", d, err)
 	}
 
 	return nil
 }
 
-func resourceProjectAssertCanDelete(d *schema.ResourceData, meta any) error {
+func resourceProjectAssertCanDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	forceDestroy := d.Get("force_destroy").(bool)
 	if forceDestroy {
 		return nil
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -167,48 +191,30 @@ func resourceProjectAssertCanDelete(d *schema.ResourceData, meta any) error {
 }
 
 func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
 	if d.Get("wait").(bool) {
-		waitInterval := PROJECT_DESTROY_WAIT_INTERVAL
-		if os.Getenv("TF_ACC") == "1" { // For acceptance tests.
-			waitInterval = time.Second
-		}
+		waitCtx, cancel := context.WithTimeout(ctx, waitTimeout(d, schema.TimeoutDelete))
+		defer cancel()
 
-		ticker := time.NewTicker(waitInterval)                  // When invoked check if project can be deleted.
-		timer := time.NewTimer(PROJECT_DESTROY_TOTAL_WAIT_TIME) // When invoked wait time has elapsed.
-		done := make(chan bool)
-
-		go func() {
-			for {
-				select {
-				case <-timer.C:
-					done <- true
-
-					return
-				case <-ticker.C:
-					err := resourceProjectAssertCanDelete(d, meta)
-					if err != nil {
-						if aeerr, ok := err.(*ActiveEnvironmentError); ok {
-							if aeerr.retry {
-								continue
-							}
-						}
-					}
-
-					done <- true
-
-					return
-				}
+		// Wait until the project can be deleted, or until an error other than an active environment.
+		err := poll(waitCtx, pollInterval(PROJECT_DESTROY_WAIT_INTERVAL), func() (bool, error) {
+			if aeerr, ok := resourceProjectAssertCanDelete(waitCtx, d, meta).(*ActiveEnvironmentError); ok && aeerr.retry {
+				return false, nil
 			}
-		}()
 
-		<-done
+			return true, nil
+		})
+		if err != nil && ctx.Err() != nil {
+			return diag.Errorf("could not delete project: timeout while waiting for the environments to be destroyed: %v", err)
+		}
 	}
 
-	if err := resourceProjectAssertCanDelete(d, meta); err != nil {
+	if err := resourceProjectAssertCanDelete(ctx, d, meta); err != nil {
 		return diag.Errorf("could not delete project: %v", err)
 	}
 
@@ -220,6 +226,8 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceProjectImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	id := d.Id()
 	_, err := uuid.Parse(id)
 
@@ -228,13 +236,13 @@ func resourceProjectImport(ctx context.Context, d *schema.ResourceData, meta any
 	if err == nil {
 		tflog.Info(ctx, "Resolving project by id", map[string]any{"id": id})
 
-		if project, err = getProjectById(id, meta); err != nil {
+		if project, err = getProjectById(ctx, id, meta); err != nil {
 			return nil, err
 		}
 	} else {
 		tflog.Info(ctx, "Resolving project by name", map[string]any{"name": id})
 
-		if project, err = getProjectByName(id, "", "", "", meta); err != nil {
+		if project, err = getProjectByName(ctx, id, "", "", "", meta); err != nil {
 			return nil, err
 		}
 	}
@@ -243,5 +251,9 @@ func resourceProjectImport(ctx context.Context, d *schema.ResourceData, meta any
 		return nil, err
 	}
 
+	if err := writeTags(d, project.Tags, nil, defaultTags(meta)); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
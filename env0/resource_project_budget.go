@@ -54,11 +54,11 @@ func resourceProjectBudgetCreateOrUpdate(ctx context.Context, d *schema.Resource
 
 	projectId := d.Get("project_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	budget, err := apiClient.ProjectBudgetUpdate(projectId, &payload)
 	if err != nil {
-		return diag.Errorf("could not create or update budget: %v", err)
+		return ApiFailure("could not create or update budget", d, err)
 	}
 
 	d.SetId(budget.Id)
@@ -67,7 +67,7 @@ func resourceProjectBudgetCreateOrUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceProjectBudgetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Get("project_id").(string)
 
@@ -86,7 +86,7 @@ func resourceProjectBudgetRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceProjectBudgetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	projectId := d.Get("project_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.ProjectBudgetDelete(projectId); err != nil {
 		return diag.Errorf("could not delete project budget: %v", err)
@@ -154,7 +154,7 @@ func resourceProjectPolicyCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceProjectPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Id()
 
@@ -173,7 +173,7 @@ func resourceProjectPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceProjectPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	payload := client.PolicyUpdatePayload{}
 
@@ -203,14 +203,14 @@ func resourceProjectPolicyUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if _, err := apiClient.PolicyUpdate(payload); err != nil {
-		return diag.Errorf("could not update policy: %v", err)
+		return ApiFailure("could not update policy", d, err)
 	}
 
 	return nil
 }
 
 func resourceProjectPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	projectId := d.Id()
 
@@ -236,7 +236,7 @@ func resourceProjectPolicyDelete(ctx context.Context, d *schema.ResourceData, me
 func resourceProjectPolicyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	projectId := d.Id()
 
-	policy, err := getPolicyByProjectId(projectId, meta)
+	policy, err := getPolicyByProjectId(ctx, projectId, meta)
 	if err != nil {
 		return nil, errors.New(err[0].Summary)
 	}
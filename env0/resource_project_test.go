@@ -160,6 +160,77 @@ func TestUnitProjectResource(t *testing.T) {
 		})
 	})
 
+	t.Run("Test project with default tags", func(t *testing.T) {
+		defaultTags := map[string]string{"env": "prod", "team": "platform"}
+
+		projectWithDefaultTags := client.Project{
+			Id:   "id0",
+			Name: "name0",
+			Tags: []string{"env:dev", "tag1", "team:platform"},
+		}
+
+		updatedProjectWithDefaultTags := client.Project{
+			Id:   projectWithDefaultTags.Id,
+			Name: projectWithDefaultTags.Name,
+			Tags: []string{"tag2", "env:prod", "team:platform"},
+		}
+
+		testCase := resource.TestCase{
+			Steps: []resource.TestStep{
+				{
+					Config: resourceConfigCreate(resourceType, resourceName, map[string]any{
+						"name": projectWithDefaultTags.Name,
+						"tags": []string{"env:dev", "tag1"},
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(accessor, "id", projectWithDefaultTags.Id),
+						resource.TestCheckResourceAttr(accessor, "tags.#", "2"),
+						resource.TestCheckResourceAttr(accessor, "tags.0", "env:dev"),
+						resource.TestCheckResourceAttr(accessor, "tags.1", "tag1"),
+						// The resource's "env" tag overrides the default "env" tag.
+						resource.TestCheckResourceAttr(accessor, "tags_all.#", "3"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.0", "env:dev"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.1", "tag1"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.2", "team:platform"),
+					),
+				},
+				{
+					Config: resourceConfigCreate(resourceType, resourceName, map[string]any{
+						"name": updatedProjectWithDefaultTags.Name,
+						"tags": []string{"tag2"},
+					}),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(accessor, "tags.#", "1"),
+						resource.TestCheckResourceAttr(accessor, "tags.0", "tag2"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.#", "3"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.0", "tag2"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.1", "env:prod"),
+						resource.TestCheckResourceAttr(accessor, "tags_all.2", "team:platform"),
+					),
+				},
+			},
+		}
+
+		runUnitTestWithDefaultTags(t, defaultTags, testCase, func(mock *client.MockApiClientInterface) {
+			mock.EXPECT().ProjectCreate(client.ProjectCreatePayload{
+				Name: projectWithDefaultTags.Name,
+				Tags: projectWithDefaultTags.Tags,
+			}).Times(1).Return(projectWithDefaultTags, nil)
+			mock.EXPECT().ProjectUpdate(updatedProjectWithDefaultTags.Id, client.ProjectUpdatePayload{
+				Name: updatedProjectWithDefaultTags.Name,
+				Tags: updatedProjectWithDefaultTags.Tags,
+			}).Times(1).Return(updatedProjectWithDefaultTags, nil)
+
+			gomock.InOrder(
+				mock.EXPECT().Project(gomock.Any()).Times(2).Return(projectWithDefaultTags, nil),        // 1 after create, 1 before update
+				mock.EXPECT().Project(gomock.Any()).Times(1).Return(updatedProjectWithDefaultTags, nil), // 1 after update
+				mock.EXPECT().ProjectEnvironments(projectWithDefaultTags.Id).Times(1).Return([]client.Environment{}, nil),
+			)
+
+			mock.EXPECT().ProjectDelete(projectWithDefaultTags.Id).Times(1)
+		})
+	})
+
 	t.Run("Test sub-project", func(t *testing.T) {
 		testCase := resource.TestCase{
 			Steps: []resource.TestStep{
@@ -38,7 +38,7 @@ func resourceProvider() *schema.Resource {
 }
 
 func resourceProviderCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ProviderCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -47,7 +47,7 @@ func resourceProviderCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	provider, err := apiClient.ProviderCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create provider: %v", err)
+		return ApiFailure("could not create provider", d, err)
 	}
 
 	d.SetId(provider.Id)
@@ -56,7 +56,7 @@ func resourceProviderCreate(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourceProviderRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	provider, err := apiClient.Provider(d.Id())
 	if err != nil {
@@ -71,7 +71,7 @@ func resourceProviderRead(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceProviderUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.ProviderUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -79,14 +79,14 @@ func resourceProviderUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	if _, err := apiClient.ProviderUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update provider: %v", err)
+		return ApiFailure("could not update provider", d, err)
 	}
 
 	return nil
 }
 
 func resourceProviderDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.ProviderDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete provider: %v", err)
@@ -95,8 +95,8 @@ func resourceProviderDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return nil
 }
 
-func getProviderByName(name string, meta any) (*client.Provider, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getProviderByName(ctx context.Context, name string, meta any) (*client.Provider, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	providers, err := apiClient.Providers()
 	if err != nil {
@@ -127,11 +127,11 @@ func getProvider(ctx context.Context, idOrName string, meta any) (*client.Provid
 	if err == nil {
 		tflog.Info(ctx, "Resolving provider by id", map[string]any{"id": idOrName})
 
-		return meta.(client.ApiClientInterface).Provider(idOrName)
+		return meta.(client.ApiClientInterface).WithContext(ctx).Provider(idOrName)
 	} else {
 		tflog.Info(ctx, "Resolving provider by name", map[string]any{"name": idOrName})
 
-		return getProviderByName(idOrName, meta)
+		return getProviderByName(ctx, idOrName, meta)
 	}
 }
 
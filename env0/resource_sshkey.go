@@ -20,6 +20,7 @@ func resourceSshKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceSshKeyImport},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name to give the ssh key",
@@ -37,7 +38,9 @@ func resourceSshKey() *schema.Resource {
 }
 
 func resourceSshKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.SshKeyCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -46,7 +49,7 @@ func resourceSshKeyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	sshKey, err := apiClient.SshKeyCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create ssh key: %v", err)
+		return ApiFailure("could not create ssh key", d, err)
 	}
 
 	d.SetId(sshKey.Id)
@@ -55,7 +58,9 @@ func resourceSshKeyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceSshKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.SshKeyUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -63,14 +68,16 @@ func resourceSshKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if _, err := apiClient.SshKeyUpdate(d.Id(), &payload); err != nil {
-		return diag.Errorf("could not update ssh key: %v", err)
+		return ApiFailure("could not update ssh key", d, err)
 	}
 
 	return nil
 }
 
 func resourceSshKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sshKey, err := getSshKeyById(d.Id(), meta)
+	meta = withOrganizationId(d, meta)
+
+	sshKey, err := getSshKeyById(ctx, d.Id(), meta)
 	if err != nil {
 		return diag.Errorf("could not get ssh key: %v", err)
 	}
@@ -78,13 +85,19 @@ func resourceSshKeyRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	if sshKey == nil {
 		tflog.Warn(ctx, "Drift Detected: Terraform will remove id from state", map[string]any{"id": d.Id()})
 		d.SetId("")
+
+		return nil
 	}
 
+	d.Set("organization_id", sshKey.OrganizationId)
+
 	return nil
 }
 
 func resourceSshKeyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -97,6 +110,8 @@ func resourceSshKeyDelete(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceSshKeyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	id := d.Id()
 
 	var getErr error
@@ -104,13 +119,13 @@ func resourceSshKeyImport(ctx context.Context, d *schema.ResourceData, meta any)
 	_, uuidErr := uuid.Parse(id)
 	if uuidErr == nil {
 		tflog.Info(ctx, "Resolving SSH key by id", map[string]any{"id": id})
-		_, getErr = getSshKeyById(id, meta)
+		_, getErr = getSshKeyById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving SSH key by name", map[string]any{"name": id})
 
 		var sshKey *client.SshKey
 
-		sshKey, getErr = getSshKeyByName(id, meta)
+		sshKey, getErr = getSshKeyByName(ctx, id, meta)
 		d.SetId(sshKey.Id)
 	}
 
@@ -64,9 +64,9 @@ func TestUnitSshKeyResource(t *testing.T) {
 		runUnitTest(t, testCase, func(mock *client.MockApiClientInterface) {
 			gomock.InOrder(
 				mock.EXPECT().SshKeyCreate(sshKeyCreatePayload).Times(1).Return(sshKey, nil),
-				mock.EXPECT().SshKeys().Times(2).Return([]client.SshKey{*sshKey}, nil),
+				mock.EXPECT().SshKeysIter().Times(2).Return(seqOf(*sshKey)),
 				mock.EXPECT().SshKeyUpdate(sshKey.Id, &sshKeyUpdatePayload).Times(1).Return(&updatedSshKey, nil),
-				mock.EXPECT().SshKeys().Times(1).Return([]client.SshKey{updatedSshKey}, nil),
+				mock.EXPECT().SshKeysIter().Times(1).Return(seqOf(updatedSshKey)),
 				mock.EXPECT().SshKeyDelete(sshKey.Id).Times(1).Return(nil),
 			)
 		})
@@ -92,10 +92,10 @@ func TestUnitSshKeyResource(t *testing.T) {
 		runUnitTest(t, createTestCase, func(mock *client.MockApiClientInterface) {
 			gomock.InOrder(
 				mock.EXPECT().SshKeyCreate(sshKeyCreatePayload).Times(1).Return(sshKey, nil),
-				mock.EXPECT().SshKeys().Times(1).Return([]client.SshKey{*sshKey}, nil),
-				mock.EXPECT().SshKeys().Times(1).Return(nil, nil),
+				mock.EXPECT().SshKeysIter().Times(1).Return(seqOf(*sshKey)),
+				mock.EXPECT().SshKeysIter().Times(1).Return(seqOf[client.SshKey]()),
 				mock.EXPECT().SshKeyCreate(sshKeyCreatePayload).Times(1).Return(sshKey, nil),
-				mock.EXPECT().SshKeys().Times(1).Return([]client.SshKey{*sshKey}, nil),
+				mock.EXPECT().SshKeysIter().Times(1).Return(seqOf(*sshKey)),
 				mock.EXPECT().SshKeyDelete(sshKey.Id).Times(1).Return(nil),
 			)
 		})
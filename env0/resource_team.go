@@ -21,6 +21,7 @@ func resourceTeam() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceTeamImport},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "The name of the team",
@@ -36,7 +37,9 @@ func resourceTeam() *schema.Resource {
 }
 
 func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -45,7 +48,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	team, err := apiClient.TeamCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create team: %v", err)
+		return ApiFailure("could not create team", d, err)
 	}
 
 	d.SetId(team.Id)
@@ -54,7 +57,9 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	team, err := apiClient.Team(d.Id())
 	if err != nil {
@@ -69,7 +74,9 @@ func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 }
 
 func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -77,14 +84,16 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	if _, err := apiClient.TeamUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update team: %v", err)
+		return ApiFailure("could not update team", d, err)
 	}
 
 	return nil
 }
 
 func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	err := apiClient.TeamDelete(d.Id())
 	if err != nil {
@@ -95,6 +104,8 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func resourceTeamImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	id := d.Id()
 
 	var getErr diag.Diagnostics
@@ -102,13 +113,13 @@ func resourceTeamImport(ctx context.Context, d *schema.ResourceData, meta any) (
 	_, uuidErr := uuid.Parse(id)
 	if uuidErr == nil {
 		tflog.Info(ctx, "Resolving team by id", map[string]any{"id": id})
-		_, getErr = getTeamById(id, meta)
+		_, getErr = getTeamById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving team by name", map[string]any{"name": id})
 
 		var team client.Team
 
-		team, getErr = getTeamByName(id, meta)
+		team, getErr = getTeamByName(ctx, id, meta)
 		d.SetId(team.Id)
 	}
 
@@ -119,8 +130,8 @@ func resourceTeamImport(ctx context.Context, d *schema.ResourceData, meta any) (
 	}
 }
 
-func getTeamByName(name string, meta any) (client.Team, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getTeamByName(ctx context.Context, name string, meta any) (client.Team, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	teams, err := apiClient.TeamsByName(name)
 	if err != nil {
@@ -146,8 +157,8 @@ func getTeamByName(name string, meta any) (client.Team, diag.Diagnostics) {
 	return teamsByName[0], nil
 }
 
-func getTeamById(id any, meta any) (client.Team, diag.Diagnostics) {
-	apiClient := meta.(client.ApiClientInterface)
+func getTeamById(ctx context.Context, id any, meta any) (client.Team, diag.Diagnostics) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	team, err := apiClient.Team(id.(string))
 	if err != nil {
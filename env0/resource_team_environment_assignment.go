@@ -40,7 +40,7 @@ func resourceTeamEnvironmentAssignment() *schema.Resource {
 }
 
 func resourceTeamEnvironmentAssignmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentCreateOrUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -49,7 +49,7 @@ func resourceTeamEnvironmentAssignmentCreateOrUpdate(ctx context.Context, d *sch
 
 	assignment, err := apiClient.TeamRoleAssignmentCreateOrUpdate(&payload)
 	if err != nil {
-		return diag.Errorf("could not create assignment: %v", err)
+		return ApiFailure("could not create assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -58,7 +58,7 @@ func resourceTeamEnvironmentAssignmentCreateOrUpdate(ctx context.Context, d *sch
 }
 
 func resourceTeamEnvironmentAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentListPayload
 
@@ -89,7 +89,7 @@ func resourceTeamEnvironmentAssignmentRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceTeamEnvironmentAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentDeletePayload
 
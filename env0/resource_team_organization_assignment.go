@@ -36,7 +36,7 @@ func resourceTeamOrganizationAssignment() *schema.Resource {
 }
 
 func resourceTeamOrganizationAssignmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organizationId, err := apiClient.OrganizationId()
 	if err != nil {
@@ -52,7 +52,7 @@ func resourceTeamOrganizationAssignmentCreateOrUpdate(ctx context.Context, d *sc
 
 	assignment, err := apiClient.TeamRoleAssignmentCreateOrUpdate(&payload)
 	if err != nil {
-		return diag.Errorf("could not create assignment: %v", err)
+		return ApiFailure("could not create assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -61,7 +61,7 @@ func resourceTeamOrganizationAssignmentCreateOrUpdate(ctx context.Context, d *sc
 }
 
 func resourceTeamOrganizationAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organizationId, err := apiClient.OrganizationId()
 	if err != nil {
@@ -98,7 +98,7 @@ func resourceTeamOrganizationAssignmentRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceTeamOrganizationAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentDeletePayload
 
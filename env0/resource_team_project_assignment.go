@@ -52,7 +52,7 @@ func resourceTeamProjectAssignment() *schema.Resource {
 }
 
 func resourceTeamProjectAssignmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentCreateOrUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -68,7 +68,7 @@ func resourceTeamProjectAssignmentCreateOrUpdate(ctx context.Context, d *schema.
 
 	assignment, err := apiClient.TeamRoleAssignmentCreateOrUpdate(&payload)
 	if err != nil {
-		return diag.Errorf("could not create assignment: %v", err)
+		return ApiFailure("could not create assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -77,7 +77,7 @@ func resourceTeamProjectAssignmentCreateOrUpdate(ctx context.Context, d *schema.
 }
 
 func resourceTeamProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentListPayload
 
@@ -112,7 +112,7 @@ func resourceTeamProjectAssignmentRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTeamProjectAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.TeamRoleAssignmentDeletePayload
 
@@ -127,7 +127,7 @@ func resourceTeamProjectAssignmentDelete(ctx context.Context, d *schema.Resource
 }
 
 func resourceTeamProjectAssignmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	splitTeamProject := strings.Split(d.Id(), "_")
 	if len(splitTeamProject) != 2 {
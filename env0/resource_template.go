@@ -280,6 +280,9 @@ func getTemplateSchema(prefix string) map[string]*schema.Schema {
 }
 
 func resourceTemplate() *schema.Resource {
+	templateSchema := getTemplateSchema("")
+	templateSchema["organization_id"] = resourceOrganizationIdSchema()
+
 	return &schema.Resource{
 		CreateContext: resourceTemplateCreate,
 		ReadContext:   resourceTemplateRead,
@@ -288,12 +291,14 @@ func resourceTemplate() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{StateContext: resourceTemplateImport},
 
-		Schema: getTemplateSchema(""),
+		Schema: templateSchema,
 	}
 }
 
 func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	request, problem := templateCreatePayloadFromParameters("", d)
 	if problem != nil {
@@ -302,7 +307,7 @@ func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	template, err := apiClient.TemplateCreate(request)
 	if err != nil {
-		return diag.Errorf("could not create template: %v", err)
+		return ApiFailure("could not create template", d, err)
 	}
 
 	d.SetId(template.Id)
@@ -311,7 +316,9 @@ func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	template, err := apiClient.Template(d.Id())
 	if err != nil {
@@ -333,7 +340,9 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	request, problem := templateCreatePayloadFromParameters("", d)
 	if problem != nil {
@@ -342,14 +351,16 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	_, err := apiClient.TemplateUpdate(d.Id(), request)
 	if err != nil {
-		return diag.Errorf("could not update template: %v", err)
+		return ApiFailure("could not update template", d, err)
 	}
 
 	return nil
 }
 
 func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -362,6 +373,8 @@ func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourceTemplateImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	meta = withOrganizationId(d, meta)
+
 	id := d.Id()
 
 	var getErr diag.Diagnostics
@@ -369,13 +382,13 @@ func resourceTemplateImport(ctx context.Context, d *schema.ResourceData, meta an
 	_, uuidErr := uuid.Parse(id)
 	if uuidErr == nil {
 		tflog.Info(ctx, "Resolving template by id", map[string]any{"id": id})
-		_, getErr = getTemplateById(id, meta)
+		_, getErr = getTemplateById(ctx, id, meta)
 	} else {
 		tflog.Info(ctx, "Resolving template by name", map[string]any{"name": id})
 
 		var template client.Template
 
-		template, getErr = getTemplateByName(id, meta)
+		template, getErr = getTemplateByName(ctx, id, meta)
 		d.SetId(template.Id)
 	}
 
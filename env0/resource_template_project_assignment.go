@@ -41,7 +41,7 @@ func templateProjectAssignmentPayloadFromParameters(d *schema.ResourceData) clie
 }
 
 func resourceTemplateProjectAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	templateId := d.Get("template_id").(string)
 	projectId := d.Get("project_id").(string)
@@ -59,7 +59,7 @@ func resourceTemplateProjectAssignmentCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceTemplateProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	templateId := d.Get("template_id").(string)
 
@@ -96,7 +96,7 @@ func resourceTemplateProjectAssignmentRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceTemplateProjectAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	templateId := d.Get("template_id").(string)
 	projectId := d.Get("project_id").(string)
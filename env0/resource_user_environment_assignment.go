@@ -45,11 +45,11 @@ func resourceUserEnvironmentAssignmentCreate(ctx context.Context, d *schema.Reso
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	client := meta.(client.ApiClientInterface)
+	client := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignment, err := client.AssignUserRoleToEnvironment(&newAssignment)
 	if err != nil {
-		return diag.Errorf("could not create assignment: %v", err)
+		return ApiFailure("could not create assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -58,7 +58,7 @@ func resourceUserEnvironmentAssignmentCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceUserEnvironmentAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(client.ApiClientInterface)
+	client := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 	environmentId := d.Get("environment_id").(string)
@@ -92,11 +92,11 @@ func resourceUserEnvironmentAssignmentUpdate(ctx context.Context, d *schema.Reso
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	client := meta.(client.ApiClientInterface)
+	client := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignment, err := client.AssignUserRoleToEnvironment(&payload)
 	if err != nil {
-		return diag.Errorf("could not update assignment: %v", err)
+		return ApiFailure("could not update assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -108,7 +108,7 @@ func resourceUserEnvironmentAssignmentDelete(ctx context.Context, d *schema.Reso
 	environmentId := d.Get("environment_id").(string)
 	userId := d.Get("user_id").(string)
 
-	client := meta.(client.ApiClientInterface)
+	client := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := client.RemoveUserRoleFromEnvironment(environmentId, userId); err != nil {
 		return diag.Errorf("could not delete assignment: %v", err)
@@ -48,7 +48,7 @@ func resourceUserOrganizationAssignment() *schema.Resource {
 }
 
 func resourceUserOrganizationAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	userId := d.Get("user_id").(string)
 
@@ -84,7 +84,7 @@ func resourceUserOrganizationAssignmentRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceUserOrganizationAssignmentCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	userId := d.Get("user_id").(string)
 
@@ -94,7 +94,7 @@ func resourceUserOrganizationAssignmentCreateOrUpdate(ctx context.Context, d *sc
 	}
 
 	if err := apiClient.OrganizationUserUpdateRole(userId, role.(string)); err != nil {
-		return diag.Errorf("failed to update user role organization: %v", err)
+		return ApiFailure("failed to update user role organization", d, err)
 	}
 
 	d.SetId(userId)
@@ -103,12 +103,12 @@ func resourceUserOrganizationAssignmentCreateOrUpdate(ctx context.Context, d *sc
 }
 
 func resourceUserOrganizationAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	userId := d.Get("user_id").(string)
 
 	if err := apiClient.OrganizationUserUpdateRole(userId, "User"); err != nil {
-		return diag.Errorf("failed to update user role organization: %v", err)
+		return ApiFailure("failed to update user role organization", d, err)
 	}
 
 	return nil
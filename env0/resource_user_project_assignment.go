@@ -62,11 +62,11 @@ func resourceUserProjectAssignmentCreate(ctx context.Context, d *schema.Resource
 
 	projectId := d.Get("project_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	assignment, err := apiClient.AssignUserToProject(projectId, &newAssignment)
 	if err != nil {
-		return diag.Errorf("could not create assignment: %v", err)
+		return ApiFailure("could not create assignment", d, err)
 	}
 
 	d.SetId(assignment.Id)
@@ -75,7 +75,7 @@ func resourceUserProjectAssignmentCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceUserProjectAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 	projectId := d.Get("project_id").(string)
@@ -123,9 +123,9 @@ func resourceUserProjectAssignmentUpdate(ctx context.Context, d *schema.Resource
 
 	payload.Role = role.(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 	if _, err := apiClient.UpdateUserProjectAssignment(projectId, userId, &payload); err != nil {
-		return diag.Errorf("could not update role for UserProjectAssignment: %v", err)
+		return ApiFailure("could not update role for UserProjectAssignment", d, err)
 	}
 
 	return nil
@@ -135,7 +135,7 @@ func resourceUserProjectAssignmentDelete(ctx context.Context, d *schema.Resource
 	projectId := d.Get("project_id").(string)
 	userId := d.Get("user_id").(string)
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.RemoveUserFromProject(projectId, userId); err != nil {
 		return diag.Errorf("could not delete UserProjectAssignment: %v", err)
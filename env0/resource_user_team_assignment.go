@@ -76,7 +76,7 @@ func resourceUserTeamAssignmentCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	utaLock.Lock()
 	defer utaLock.Unlock()
@@ -101,7 +101,7 @@ func resourceUserTeamAssignmentCreate(ctx context.Context, d *schema.ResourceDat
 		Description: team.Description,
 		UserIds:     userIds,
 	}); err != nil {
-		return diag.Errorf("could not update team with new assignment: %v", err)
+		return ApiFailure("could not update team with new assignment", d, err)
 	}
 
 	d.SetId(newAssignment.GetId())
@@ -115,7 +115,7 @@ func resourceUserTeamAssignmentRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("%v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	utaLock.Lock()
 	defer utaLock.Unlock()
@@ -155,7 +155,7 @@ func resourceUserTeamAssignmentDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("schema resource data deserialization failed: %v", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	utaLock.Lock()
 	defer utaLock.Unlock()
@@ -180,7 +180,7 @@ func resourceUserTeamAssignmentDelete(ctx context.Context, d *schema.ResourceDat
 		Description: team.Description,
 		UserIds:     userIds,
 	}); err != nil {
-		return diag.Errorf("could not update team with removed assignment: %v", err)
+		return ApiFailure("could not update team with removed assignment", d, err)
 	}
 
 	return nil
@@ -192,7 +192,7 @@ func resourceUserTeamAssignmentImport(ctx context.Context, d *schema.ResourceDat
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	utaLock.Lock()
 	defer utaLock.Unlock()
@@ -271,7 +271,7 @@ func getVariablesFromSchema(d *schema.ResourceData, organizationId string) ([]cl
 func resourceVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var err error
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organizationId, err := apiClient.OrganizationId()
 	if err != nil {
@@ -293,7 +293,7 @@ func resourceVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	configurationSet, err := apiClient.ConfigurationSetCreate(&payload)
 	if err != nil {
-		return diag.Errorf("failed to create a variable set: %v", err)
+		return ApiFailure("failed to create a variable set", d, err)
 	}
 
 	d.SetId(configurationSet.Id)
@@ -302,7 +302,7 @@ func resourceVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -368,7 +368,7 @@ func mergeVariables(schema []client.ConfigurationVariable, api []client.Configur
 }
 
 func resourceVariableSetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	id := d.Id()
 
@@ -409,7 +409,7 @@ func resourceVariableSetUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	organizationId, err := apiClient.OrganizationId()
 	if err != nil {
@@ -473,7 +473,7 @@ func resourceVariableSetUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if _, err := apiClient.ConfigurationSetUpdate(id, &payload); err != nil {
-		return diag.Errorf("failed to update a variable set: %v", err)
+		return ApiFailure("failed to update a variable set", d, err)
 	}
 
 	return nil
@@ -52,7 +52,7 @@ func resourceVariableSetAssignment() *schema.Resource {
 }
 
 func resourceVariableSetAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignmentSchema variableSetAssignmentSchema
 
@@ -72,7 +72,7 @@ func resourceVariableSetAssignmentCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceVariableSetAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignmentSchema variableSetAssignmentSchema
 
@@ -130,7 +130,7 @@ func resourceVariableSetAssignmentUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceVariableSetAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignmentSchema variableSetAssignmentSchema
 
@@ -148,7 +148,7 @@ func resourceVariableSetAssignmentDelete(ctx context.Context, d *schema.Resource
 }
 
 func resourceVariableSetAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var assignmentSchema variableSetAssignmentSchema
 
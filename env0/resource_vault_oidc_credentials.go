@@ -19,6 +19,7 @@ func resourceVaultOidcCredentials() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceCredentialsImport(VAULT_OIDC_TYPE)},
 
 		Schema: map[string]*schema.Schema{
+			"organization_id": resourceOrganizationIdSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name for the oidc credentials",
@@ -77,7 +78,9 @@ func vaultOidcCredentialsGetValue(d *schema.ResourceData) (client.VaultCredentia
 }
 
 func resourceVaultOidcCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := vaultOidcCredentialsGetValue(d)
 	if err != nil {
@@ -93,7 +96,7 @@ func resourceVaultOidcCredentialsCreate(ctx context.Context, d *schema.ResourceD
 
 	credentials, err := apiClient.CredentialsCreate(&request)
 	if err != nil {
-		return diag.Errorf("could not create vault oidc credentials: %v", err)
+		return ApiFailure("could not create vault oidc credentials", d, err)
 	}
 
 	d.SetId(credentials.Id)
@@ -102,7 +105,9 @@ func resourceVaultOidcCredentialsCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceVaultOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	meta = withOrganizationId(d, meta)
+
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	value, err := vaultOidcCredentialsGetValue(d)
 	if err != nil {
@@ -115,7 +120,7 @@ func resourceVaultOidcCredentialsUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if _, err := apiClient.CredentialsUpdate(d.Id(), &request); err != nil {
-		return diag.Errorf("could not update vault oidc credentials: %s %v", d.Id(), err)
+		return ApiFailure(fmt.Sprintf("could not update vault oidc credentials %s", d.Id()), d, err)
 	}
 
 	return nil
@@ -52,7 +52,7 @@ func resourceVcsConnection() *schema.Resource {
 }
 
 func resourceVcsConnectionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.VcsConnectionCreatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -61,7 +61,7 @@ func resourceVcsConnectionCreate(ctx context.Context, d *schema.ResourceData, me
 
 	vcsConnection, err := apiClient.VcsConnectionCreate(payload)
 	if err != nil {
-		return diag.Errorf("could not create VCS connection: %v", err)
+		return ApiFailure("could not create VCS connection", d, err)
 	}
 
 	d.SetId(vcsConnection.Id)
@@ -70,7 +70,7 @@ func resourceVcsConnectionCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceVcsConnectionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	vcsConnection, err := apiClient.VcsConnection(d.Id())
 	if err != nil {
@@ -85,7 +85,7 @@ func resourceVcsConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceVcsConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	var payload client.VcsConnectionUpdatePayload
 	if err := readResourceData(&payload, d); err != nil {
@@ -93,14 +93,14 @@ func resourceVcsConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if _, err := apiClient.VcsConnectionUpdate(d.Id(), payload); err != nil {
-		return diag.Errorf("could not update VCS connection: %v", err)
+		return ApiFailure("could not update VCS connection", d, err)
 	}
 
 	return resourceVcsConnectionRead(ctx, d, meta)
 }
 
 func resourceVcsConnectionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	if err := apiClient.VcsConnectionDelete(d.Id()); err != nil {
 		return diag.Errorf("could not delete VCS connection: %v", err)
@@ -109,8 +109,8 @@ func resourceVcsConnectionDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func getVcsConnectionByName(name string, meta any) (*client.VcsConnection, error) {
-	apiClient := meta.(client.ApiClientInterface)
+func getVcsConnectionByName(ctx context.Context, name string, meta any) (*client.VcsConnection, error) {
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	vcsConnections, err := apiClient.VcsConnections()
 	if err != nil {
@@ -140,12 +140,12 @@ func getVcsConnection(ctx context.Context, id string, meta any) (*client.VcsConn
 	if _, err := uuid.Parse(id); err == nil {
 		tflog.Info(ctx, "Resolving VCS connection by id", map[string]any{"id": id})
 
-		return meta.(client.ApiClientInterface).VcsConnection(id)
+		return meta.(client.ApiClientInterface).WithContext(ctx).VcsConnection(id)
 	}
 
 	tflog.Info(ctx, "Resolving VCS connection by name", map[string]any{"name": id})
 
-	return getVcsConnectionByName(id, meta)
+	return getVcsConnectionByName(ctx, id, meta)
 }
 
 func resourceVcsConnectionImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
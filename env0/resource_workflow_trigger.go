@@ -34,7 +34,7 @@ func resourceWorkflowTrigger() *schema.Resource {
 }
 
 func resourceWorkflowTriggerRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 	downstreamEnvironmentId := d.Get("downstream_environment_id").(string)
@@ -57,7 +57,7 @@ func resourceWorkflowTriggerRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceWorkflowTriggerCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 	downstreamEnvironmentId := d.Get("downstream_environment_id").(string)
@@ -74,7 +74,7 @@ func resourceWorkflowTriggerCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceWorkflowTriggerDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 	downstreamEnvironmentId := d.Get("downstream_environment_id").(string)
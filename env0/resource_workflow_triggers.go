@@ -37,7 +37,7 @@ func resourceWorkflowTriggers() *schema.Resource {
 }
 
 func resourceWorkflowTriggersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	environmentId := d.Get("environment_id").(string)
 
@@ -57,7 +57,7 @@ func resourceWorkflowTriggersRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceWorkflowTriggersCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 	environmentId := d.Get("environment_id").(string)
 	rawDownstreamIds := d.Get("downstream_environment_ids").([]any)
 
@@ -88,7 +88,7 @@ func resourceWorkflowTriggersCreateOrUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceWorkflowTriggersDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	apiClient := meta.(client.ApiClientInterface)
+	apiClient := meta.(client.ApiClientInterface).WithContext(ctx)
 
 	_, err := apiClient.WorkflowTriggerUpsert(d.Id(), client.WorkflowTriggerUpsertPayload{DownstreamEnvironmentIds: []string{}})
 	if err != nil {
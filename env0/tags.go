@@ -0,0 +1,139 @@
+package env0
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// A default tag (see "default_tags") is added as a "key:value" tag.
+const tagKeyValueSeparator = ":"
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "tags that are added to every resource that supports tags (E.g. env0_project). A tag is added as 'key:value', unless the resource already has a tag with the same key (a 'key' or 'key:...' tag)",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "all the tags of the resource, including the provider's default_tags",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func readDefaultTags(d *schema.ResourceData) map[string]string {
+	defaults := map[string]string{}
+
+	for key, value := range d.Get("default_tags").(map[string]any) {
+		defaults[key] = value.(string)
+	}
+
+	return defaults
+}
+
+func tagKey(tag string) string {
+	key, _, _ := strings.Cut(tag, tagKeyValueSeparator)
+
+	return key
+}
+
+// toTags converts a list of tags (E.g. d.Get("tags")) to strings.
+func toTags(list any) []string {
+	var tags []string
+
+	for _, tag := range list.([]any) {
+		if tag, ok := tag.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// mergeDefaultTags returns the tags, followed by the default tags (sorted by key) whose key isn't used by any of the tags.
+func mergeDefaultTags(tags []string, defaults map[string]string) []string {
+	if len(defaults) == 0 {
+		return tags
+	}
+
+	keys := map[string]bool{}
+	for _, tag := range tags {
+		keys[tagKey(tag)] = true
+	}
+
+	merged := slices.Clone(tags)
+
+	for _, key := range slices.Sorted(maps.Keys(defaults)) {
+		if !keys[key] {
+			merged = append(merged, key+tagKeyValueSeparator+defaults[key])
+		}
+	}
+
+	return merged
+}
+
+// withoutDefaultTags returns the tags of the resource, without the tags that were added by mergeDefaultTags.
+// configured are the tags of the resource (before the merge). A default tag that is also a configured tag is kept.
+func withoutDefaultTags(tagsAll []string, configured []string, defaults map[string]string) []string {
+	configuredKeys := map[string]bool{}
+	for _, tag := range configured {
+		configuredKeys[tagKey(tag)] = true
+	}
+
+	var tags []string
+
+	for _, tag := range tagsAll {
+		key := tagKey(tag)
+
+		if value, ok := defaults[key]; ok && tag == key+tagKeyValueSeparator+value && !configuredKeys[key] {
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// writeTags writes the tags of the resource (as returned by the API) to "tags_all", and to "tags" without the default tags.
+// configured are the tags of the resource before it was read.
+func writeTags(d *schema.ResourceData, tagsAll []string, configured []string, defaults map[string]string) error {
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return err
+	}
+
+	if len(defaults) == 0 {
+		return nil
+	}
+
+	return d.Set("tags", withoutDefaultTags(tagsAll, configured, defaults))
+}
+
+// customizeTagsAllDiff plans "tags_all" (the tags with the default tags). The default tags don't change "tags", so they don't cause a drift.
+func customizeTagsAllDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tagsAll := mergeDefaultTags(toTags(diff.Get("tags")), defaultTags(meta))
+	current := toTags(diff.Get("tags_all"))
+
+	if diff.Id() != "" && slices.Equal(slices.Sorted(slices.Values(current)), slices.Sorted(slices.Values(tagsAll))) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
@@ -0,0 +1,113 @@
+package env0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost-center": "1234"}
+
+	assert.Equal(t, []string{"tag1", "cost-center:1234", "team:platform"}, mergeDefaultTags([]string{"tag1"}, defaults))
+	assert.Equal(t, []string{"team:devops", "cost-center:1234"}, mergeDefaultTags([]string{"team:devops"}, defaults), "resource tags win")
+	assert.Equal(t, []string{"team", "cost-center:1234"}, mergeDefaultTags([]string{"team"}, defaults), "a tag without a value wins")
+	assert.Equal(t, []string{"tag1"}, mergeDefaultTags([]string{"tag1"}, nil))
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost-center": "1234"}
+
+	assert.Equal(t, []string{"tag1"}, withoutDefaultTags([]string{"tag1", "cost-center:1234", "team:platform"}, []string{"tag1"}, defaults))
+	assert.Equal(t, []string{"team:platform"}, withoutDefaultTags([]string{"team:platform", "cost-center:1234"}, []string{"team:platform"}, defaults), "a configured tag is kept")
+	assert.Equal(t, []string{"cost-center:5678"}, withoutDefaultTags([]string{"cost-center:5678"}, nil, defaults), "a tag with another value isn't a default tag")
+	assert.Nil(t, withoutDefaultTags([]string{"cost-center:1234", "team:platform"}, nil, defaults))
+}
+
+func TestProjectDefaultTags(t *testing.T) {
+	defaults := map[string]string{"team": "platform"}
+
+	project := client.Project{
+		Id:   "project-id",
+		Name: "project",
+		Tags: []string{"tag1", "team:platform"},
+	}
+
+	ctrl := gomock.NewController(t)
+	apiClientMock := client.NewMockApiClientInterface(ctrl)
+	apiClientMock.EXPECT().WithContext(gomock.Any()).Return(apiClientMock).AnyTimes()
+	apiClientMock.EXPECT().WithOrganizationId(gomock.Any()).Return(apiClientMock).AnyTimes()
+	apiClientMock.EXPECT().ProjectCreate(client.ProjectCreatePayload{Name: "project", Tags: []string{"tag1", "team:platform"}}).Return(project, nil)
+	apiClientMock.EXPECT().Project("project-id").Return(project, nil)
+
+	meta := &providerMeta{ApiClientInterface: apiClientMock, defaultTags: defaults}
+
+	d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{
+		"name": "project",
+		"tags": []any{"tag1"},
+	})
+
+	require.Empty(t, resourceProjectCreate(context.Background(), d, meta))
+	require.Empty(t, resourceProjectRead(context.Background(), d, meta))
+
+	assert.Equal(t, []any{"tag1"}, d.Get("tags"))
+	assert.Equal(t, []any{"tag1", "team:platform"}, d.Get("tags_all"))
+}
+
+func TestReadDefaultTags(t *testing.T) {
+	assert.Equal(t, map[string]string{"team": "platform"}, readDefaultTags(providerResourceData(t, map[string]any{
+		"default_tags": map[string]any{"team": "platform"},
+	})))
+	assert.Empty(t, readDefaultTags(providerResourceData(t, map[string]any{})))
+}
+
+func TestProjectTagsAllDiff(t *testing.T) {
+	meta := &providerMeta{defaultTags: map[string]string{"env": "prod", "team": "platform"}}
+
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"name": "project",
+		"tags": []any{"env:dev", "tag1"},
+	})
+
+	t.Run("create", func(t *testing.T) {
+		instanceDiff, err := resourceProject().Diff(context.Background(), nil, config, meta)
+		require.NoError(t, err)
+
+		// The resource's "env" tag overrides the default "env" tag.
+		assert.Equal(t, "3", instanceDiff.Attributes["tags_all.#"].New)
+		assert.Equal(t, "env:dev", instanceDiff.Attributes["tags_all.0"].New)
+		assert.Equal(t, "tag1", instanceDiff.Attributes["tags_all.1"].New)
+		assert.Equal(t, "team:platform", instanceDiff.Attributes["tags_all.2"].New)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		state := &terraform.InstanceState{
+			ID: "project-id",
+			Attributes: map[string]string{
+				"id":            "project-id",
+				"name":          "project",
+				"force_destroy": "false",
+				"tags.#":        "2",
+				"tags.0":        "env:dev",
+				"tags.1":        "tag1",
+				"tags_all.#":    "3",
+				"tags_all.0":    "team:platform",
+				"tags_all.1":    "env:dev",
+				"tags_all.2":    "tag1",
+			},
+		}
+
+		instanceDiff, err := resourceProject().Diff(context.Background(), state, config, meta)
+		require.NoError(t, err)
+
+		if instanceDiff != nil {
+			assert.NotContains(t, instanceDiff.Attributes, "tags_all.#")
+		}
+	})
+}
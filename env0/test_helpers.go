@@ -2,6 +2,7 @@ package env0
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 	"regexp"
 
@@ -108,3 +109,14 @@ func missingArgumentTestCaseForCostCred(resourceType string, resourceName string
 
 	return testCaseFormMissingValidInputError
 }
+
+// seqOf returns a sequence that yields items (mocks the iterators of the api client).
+func seqOf[T any](items ...T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package env0
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/env0/terraform-provider-env0/env0"
+
+// tracer returns the tracer of the global tracer provider (a no-op tracer unless tracing is enabled).
+func tracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer(tracerName)
+}
+
+// tracingEnabled returns true if traces should be exported, according to the standard OpenTelemetry environment variables.
+// Tracing is opt-in: it's enabled when an OTLP endpoint is set (or the OTLP exporter is selected explicitly).
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+
+	switch os.Getenv("OTEL_TRACES_EXPORTER") {
+	case "otlp":
+		return true
+	case "":
+		return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+	default:
+		// "none", or an exporter that isn't supported.
+		return false
+	}
+}
+
+func newTraceExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	// The exporters are configured by the OTEL_EXPORTER_OTLP_* environment variables (endpoint, headers, TLS, timeout).
+	switch protocol := cmp.Or(os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"), os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"), "http/protobuf"); protocol {
+	case "grpc":
+		return otlptracegrpc.New(ctx)
+	case "http/protobuf":
+		return otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q (supported protocols: grpc, http/protobuf)", protocol)
+	}
+}
+
+// SetupTracing sets the global tracer provider to export traces over OTLP, if enabled by the standard OpenTelemetry
+// environment variables (E.g. OTEL_EXPORTER_OTLP_ENDPOINT). The returned function flushes and stops the tracer provider.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if !tracingEnabled() {
+		return noop, nil
+	}
+
+	exporter, err := newTraceExporter(ctx)
+	if err != nil {
+		return noop, err
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over the defaults.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "terraform-provider-env0"),
+			attribute.String("service.version", version),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// traceOperation wraps a CRUD function of a resource (or a data source) with a span.
+func traceOperation[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](name string, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		ctx, span := tracer().Start(ctx, name+" "+operation, trace.WithAttributes(
+			attribute.String("terraform.resource.type", name),
+			attribute.String("terraform.operation", operation),
+		))
+		defer span.End()
+
+		diags := f(ctx, d, meta)
+
+		span.SetAttributes(attribute.String("terraform.resource.id", d.Id()))
+
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				span.SetStatus(codes.Error, diagnostic.Summary)
+
+				break
+			}
+		}
+
+		return diags
+	}
+}
+
+func traceImport(name string, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		ctx, span := tracer().Start(ctx, name+" import", trace.WithAttributes(
+			attribute.String("terraform.resource.type", name),
+			attribute.String("terraform.operation", "import"),
+			attribute.String("terraform.resource.id", d.Id()),
+		))
+		defer span.End()
+
+		result, err := f(ctx, d, meta)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return result, err
+	}
+}
+
+// traceResources adds a span to every CRUD operation of the resources and data sources of the provider.
+// API requests made during an operation are child spans of it (see the client/http package).
+func traceResources(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		r.CreateContext = traceOperation(name, "create", r.CreateContext)
+		r.ReadContext = traceOperation(name, "read", r.ReadContext)
+		r.UpdateContext = traceOperation(name, "update", r.UpdateContext)
+		r.DeleteContext = traceOperation(name, "delete", r.DeleteContext)
+
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			r.Importer.StateContext = traceImport(name, r.Importer.StateContext)
+		}
+	}
+
+	for name, r := range provider.DataSourcesMap {
+		r.ReadContext = traceOperation("data."+name, "read", r.ReadContext)
+	}
+}
@@ -0,0 +1,137 @@
+package env0
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/env0/terraform-provider-env0/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+)
+
+// useInMemoryTracer sets the global tracer provider to export to an in-memory exporter (for the duration of the test).
+func useInMemoryTracer(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	previousTracerProvider := otel.GetTracerProvider()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previousTracerProvider) })
+
+	return exporter
+}
+
+func TestTraceResources(t *testing.T) {
+	exporter := useInMemoryTracer(t)
+
+	ctrl := gomock.NewController(t)
+	apiClientMock := client.NewMockApiClientInterface(ctrl)
+	apiClientMock.EXPECT().WithContext(gomock.Any()).Return(apiClientMock).AnyTimes()
+	apiClientMock.EXPECT().WithOrganizationId(gomock.Any()).Return(apiClientMock).AnyTimes()
+	apiClientMock.EXPECT().TeamCreate(gomock.Any()).Return(client.Team{Id: "team0", Name: "team"}, nil)
+	apiClientMock.EXPECT().Team("team0").Return(client.Team{}, errors.New("internal error"))
+
+	provider := Provider("")()
+	teamResource := provider.ResourcesMap["env0_team"]
+	d := schema.TestResourceDataRaw(t, teamResource.Schema, map[string]any{"name": "team"})
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "apply")
+
+	require.Empty(t, teamResource.CreateContext(ctx, d, apiClientMock))
+	require.NotEmpty(t, teamResource.ReadContext(ctx, d, apiClientMock))
+
+	parent.End()
+
+	var spans tracetest.SpanStubs
+
+	for _, span := range exporter.GetSpans() {
+		if span.SpanContext.TraceID() == parent.SpanContext().TraceID() && span.Name != "apply" {
+			spans = append(spans, span)
+		}
+	}
+
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "env0_team create", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Contains(t, spans[0].Attributes, attribute.String("terraform.resource.id", "team0"))
+	assert.Contains(t, spans[0].Attributes, attribute.String("terraform.operation", "create"))
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+
+	assert.Equal(t, "env0_team read", spans[1].Name)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Contains(t, spans[1].Status.Description, "internal error")
+
+	assert.NotNil(t, provider.DataSourcesMap["env0_team"].ReadContext)
+	assert.Nil(t, provider.ResourcesMap["env0_agent_secret"].UpdateContext)
+}
+
+func TestTracingEnabled(t *testing.T) {
+	for _, testCase := range []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{"disabled by default", map[string]string{}, false},
+		{"otlp endpoint", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, true},
+		{"otlp traces endpoint", map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"}, true},
+		{"otlp exporter", map[string]string{"OTEL_TRACES_EXPORTER": "otlp"}, true},
+		{"none exporter", map[string]string{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, false},
+		{"sdk disabled", map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, false},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, name := range []string{"OTEL_SDK_DISABLED", "OTEL_TRACES_EXPORTER", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+				t.Setenv(name, testCase.env[name])
+			}
+
+			assert.Equal(t, testCase.expected, tracingEnabled())
+		})
+	}
+}
+
+func TestSetupTracing(t *testing.T) {
+	var exportRequests atomic.Int32
+
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/traces" {
+			exportRequests.Add(1)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	previousTracerProvider := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previousTracerProvider) })
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collector.URL)
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")
+
+	shutdown, err := SetupTracing(context.Background(), "test")
+	require.NoError(t, err)
+
+	_, span := tracer().Start(context.Background(), "env0_project create")
+	span.End()
+
+	require.NoError(t, shutdown(context.Background()))
+	assert.Equal(t, int32(1), exportRequests.Load())
+
+	t.Run("unsupported protocol", func(t *testing.T) {
+		t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/json")
+
+		_, err := SetupTracing(context.Background(), "test")
+		assert.ErrorContains(t, err, `unsupported OTLP protocol "http/json"`)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/adhocore/gronx"
 	"github.com/env0/terraform-provider-env0/client"
@@ -152,3 +153,18 @@ func ValidateUrl(i any, path cty.Path) diag.Diagnostics {
 
 	return nil
 }
+
+func ValidateDuration(i any, path cty.Path) diag.Diagnostics {
+	v := i.(string)
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return diag.Errorf("must be a valid duration (e.g. \"30s\", \"1m\"): %v", err)
+	}
+
+	if d < 0 {
+		return diag.Errorf("must not be a negative duration")
+	}
+
+	return nil
+}
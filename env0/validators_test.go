@@ -167,3 +167,45 @@ func TestValidateRetries(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDuration(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{
+			name:        "seconds",
+			value:       "30s",
+			expectError: false,
+		},
+		{
+			name:        "milliseconds",
+			value:       "500ms",
+			expectError: false,
+		},
+		{
+			name:        "missing unit",
+			value:       "30",
+			expectError: true,
+		},
+		{
+			name:        "negative",
+			value:       "-1s",
+			expectError: true,
+		},
+		{
+			name:        "empty",
+			value:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := ValidateDuration(tt.value, cty.Path{})
+			hasError := diags.HasError()
+			assert.Equal(t, tt.expectError, hasError)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package env0
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The timeout of waits in acceptance tests.
+const acceptanceTestWaitTimeout = time.Second * 10
+
+func isAcceptanceTest() bool {
+	return os.Getenv("TF_ACC") == "1"
+}
+
+// pollInterval returns the interval between polls of a long-running operation (1 second in acceptance tests).
+func pollInterval(interval time.Duration) time.Duration {
+	if isAcceptanceTest() {
+		return time.Second
+	}
+
+	return interval
+}
+
+// waitTimeout returns the timeout of an operation ("create", "update" or "delete"), as configured by the "timeouts" block
+// of the resource. The context of the operation has the same deadline. Reduced to 10 seconds in acceptance tests.
+func waitTimeout(d *schema.ResourceData, operation string) time.Duration {
+	timeout := d.Timeout(operation)
+
+	if isAcceptanceTest() {
+		return min(timeout, acceptanceTestWaitTimeout)
+	}
+
+	return timeout
+}
+
+// poll calls check every interval until it's done or fails, or until ctx is done (E.g. when the timeout of the operation
+// elapses). Returns the error of check, or the error of ctx.
+func poll(ctx context.Context, interval time.Duration, check func() (done bool, err error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if err == nil && done {
+			return nil
+		}
+
+		// A request that was aborted because ctx is done fails with the error of ctx.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTimeout returns true if err is the error of a context whose deadline (the timeout of the operation) was exceeded.
+func isTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
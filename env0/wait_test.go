@@ -0,0 +1,53 @@
+package env0
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	t.Run("done", func(t *testing.T) {
+		polls := 0
+
+		err := poll(context.Background(), time.Millisecond, func() (bool, error) {
+			polls++
+
+			return polls == 3, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, polls)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := poll(context.Background(), time.Millisecond, func() (bool, error) {
+			return false, errors.New("error")
+		})
+		assert.EqualError(t, err, "error")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+		defer cancel()
+
+		err := poll(ctx, time.Millisecond, func() (bool, error) {
+			return false, nil
+		})
+		assert.True(t, isTimeout(err))
+	})
+
+	t.Run("request aborted by the timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+		defer cancel()
+
+		err := poll(ctx, time.Millisecond, func() (bool, error) {
+			<-ctx.Done()
+
+			return false, errors.New("request failed")
+		})
+		assert.True(t, isTimeout(err))
+	})
+}
@@ -0,0 +1,26 @@
+data "env0_deployment" "latest" {
+  environment_id = "environment_id"
+}
+
+data "env0_deployment" "by_id" {
+  id = "deployment_id"
+}
+
+output "latest_deployment_status" {
+  value = data.env0_deployment.latest.status
+}
+
+output "resources_to_destroy" {
+  value = length(data.env0_deployment.latest.plan_summary) > 0 ? data.env0_deployment.latest.plan_summary[0].destroyed : 0
+}
+
+resource "env0_environment_deployment" "dependent" {
+  environment_id = "dependent_environment_id"
+
+  lifecycle {
+    precondition {
+      condition     = data.env0_deployment.latest.is_successful
+      error_message = "the last deployment failed: ${data.env0_deployment.latest.error_message}"
+    }
+  }
+}
@@ -0,0 +1,21 @@
+data "env0_environment_outputs" "network" {
+  environment_id = "environment_id"
+}
+
+output "vpc_id" {
+  value = data.env0_environment_outputs.network.values["vpc_id"]
+}
+
+output "subnet_ids" {
+  value = jsondecode(data.env0_environment_outputs.network.values["subnet_ids"])
+}
+
+output "database_password" {
+  value     = data.env0_environment_outputs.network.sensitive_values["database_password"]
+  sensitive = true
+}
+
+# Terragrunt run-all deployments are flattened: the key of an output is "<module>.<name>".
+output "db_port" {
+  value = data.env0_environment_outputs.network.values["db.port"]
+}
@@ -0,0 +1,23 @@
+data "env0_environment" "example" {
+  name = "Environment Name"
+}
+
+resource "env0_environment_deployment" "example" {
+  environment_id      = data.env0_environment.example.id
+  revision            = "v1.2.0"
+  wait_for_deployment = true
+
+  triggers = {
+    config_version = "3"
+  }
+
+  configuration {
+    name  = "region"
+    value = "us-east-1"
+    type  = "terraform"
+  }
+}
+
+output "deployment_status" {
+  value = env0_environment_deployment.example.status
+}
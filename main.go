@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/env0/terraform-provider-env0/env0"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -27,5 +29,20 @@ func main() {
 		opts.Debug = true
 	}
 
+	ctx := context.Background()
+
+	// Tracing is enabled by the standard OpenTelemetry environment variables (E.g. OTEL_EXPORTER_OTLP_ENDPOINT).
+	shutdownTracing, err := env0.SetupTracing(ctx, version)
+	if err != nil {
+		log.Printf("[WARN] failed to set up tracing: %v", err)
+	}
+
 	plugin.Serve(opts)
+
+	env0.ReportUsage()
+	env0.CloseAuditLogs()
+
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] failed to export traces: %v", err)
+	}
 }